@@ -169,6 +169,34 @@ func Compare[T Ordered](v1 T, v2 T) Order {
 	}
 }
 
+// COMPARATORS
+
+// Create a comparison function that compares values by a derived property.
+func Comparing[T any, U Ordered](mapfn func(value T) U) func(a, b T) Order {
+	return func(a, b T) Order {
+		return Compare(mapfn(a), mapfn(b))
+	}
+}
+
+// Create a comparison function that uses the first comparison function,
+// and breaks ties with the next ones, in order.
+func ThenComparing[T any](cmpfn func(a, b T) Order, next ...func(a, b T) Order) func(a, b T) Order {
+	return func(a, b T) Order {
+		order := cmpfn(a, b)
+		for i := 0; order == EQ && i < len(next); i++ {
+			order = next[i](a, b)
+		}
+		return order
+	}
+}
+
+// Create a comparison function that orders the opposite way.
+func Reversed[T any](cmpfn func(a, b T) Order) func(a, b T) Order {
+	return func(a, b T) Order {
+		return cmpfn(b, a)
+	}
+}
+
 // BOOLEANS
 
 // Negate a boolean value.
@@ -240,6 +268,58 @@ func LogBase[T Number](base, value T) T {
 	return T(math.Log(float64(value)) / math.Log(float64(base)))
 }
 
+// MONOIDS
+
+// An associative way of combining values, together with its identity value.
+// For every a, b and c a Monoid has to satisfy:
+//
+//	Combine(a, Combine(b, c)) == Combine(Combine(a, b), c)
+//	Combine(Empty(), a) == a == Combine(a, Empty())
+//
+// These laws allow parallel reductions to combine partial results in any grouping.
+type Monoid[T any] interface {
+	Empty() T
+	Combine(a, b T) T
+}
+
+type monoid[T any] struct {
+	empty   T
+	combine func(a, b T) T
+}
+
+func (m monoid[T]) Empty() T {
+	return m.empty
+}
+
+func (m monoid[T]) Combine(a, b T) T {
+	return m.combine(a, b)
+}
+
+// Create a Monoid from an identity value and an associative combining function.
+func NewMonoid[T any](empty T, combine func(a, b T) T) Monoid[T] {
+	return monoid[T]{empty, combine}
+}
+
+// Combine numbers by adding them together.
+func SumMonoid[T Number]() Monoid[T] {
+	return NewMonoid(T(0), Add[T])
+}
+
+// Combine numbers by multiplying them together.
+func ProductMonoid[T Number]() Monoid[T] {
+	return NewMonoid(T(1), Mul[T])
+}
+
+// Combine booleans with the logical AND operator.
+func AllMonoid() Monoid[bool] {
+	return NewMonoid(true, And)
+}
+
+// Combine booleans with the logical OR operator.
+func AnyMonoid() Monoid[bool] {
+	return NewMonoid(false, Or)
+}
+
 // FUNCTION HELPERS
 
 // Given a value, returns exactly the same value. This is called the identity function.
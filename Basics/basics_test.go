@@ -0,0 +1,36 @@
+package Basics_test
+
+import (
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+)
+
+type point struct{ x, y int }
+
+func TestComparators(t *testing.T) {
+	byX := Basics.Comparing(func(p point) int { return p.x })
+	byY := Basics.Comparing(func(p point) int { return p.y })
+	cases := []struct {
+		name  string
+		cmpfn func(a, b point) Basics.Order
+		a, b  point
+		want  Basics.Order
+	}{
+		{"Comparing less", byX, point{1, 9}, point{2, 0}, Basics.LT},
+		{"Comparing equal", byX, point{1, 9}, point{1, 0}, Basics.EQ},
+		{"Comparing greater", byX, point{3, 0}, point{2, 9}, Basics.GT},
+		{"ThenComparing decided by the first", Basics.ThenComparing(byX, byY), point{1, 9}, point{2, 0}, Basics.LT},
+		{"ThenComparing breaks ties", Basics.ThenComparing(byX, byY), point{1, 9}, point{1, 0}, Basics.GT},
+		{"ThenComparing without a tie breaker", Basics.ThenComparing(byX), point{1, 9}, point{1, 0}, Basics.EQ},
+		{"ThenComparing with all keys equal", Basics.ThenComparing(byX, byY, byY), point{1, 1}, point{1, 1}, Basics.EQ},
+		{"Reversed", Basics.Reversed(byX), point{1, 0}, point{2, 0}, Basics.GT},
+		{"Reversed equal", Basics.Reversed(byX), point{1, 0}, point{1, 5}, Basics.EQ},
+		{"Reversed tie breaker", Basics.ThenComparing(byX, Basics.Reversed(byY)), point{1, 9}, point{1, 0}, Basics.LT},
+	}
+	for _, c := range cases {
+		if got := c.cmpfn(c.a, c.b); got != c.want {
+			t.Errorf("%s(%v, %v) = %d, want %d", c.name, c.a, c.b, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,363 @@
+// Structural equality and diffing of arbitrary values, with readable reports.
+//
+// Unlike `reflect.DeepEqual`, the gofp types are compared by what they mean rather than by their private fields:
+// a `Maybe` is either Nothing or Just a value, a `Result` is either Ok or an Err (compared by message)
+// and a `Set` is compared by its elements.
+// Slices are diffed with a shortest edit script, so an insertion is reported as a single added element.
+package Deep
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unsafe"
+
+	"github.com/manwitha1000names/gofp/v3/List"
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Set"
+)
+
+// The kind of a change between two values.
+type Kind int
+
+const (
+	Added Kind = iota
+	Removed
+	Changed
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Added:
+		return "+"
+	case Removed:
+		return "-"
+	default:
+		return "~"
+	}
+}
+
+// A single difference between two values.
+// Path locates the difference inside the values, like .Fst[2]["key"].Just
+// Old is not set for an `Added` change, New is not set for a `Removed` change.
+type Change struct {
+	Path string
+	Kind Kind
+	Old  any
+	New  any
+}
+
+func (c Change) String() string {
+	path := c.Path
+	if path == "" {
+		path = "."
+	}
+	switch c.Kind {
+	case Added:
+		return fmt.Sprintf("+ %s: %s", path, show(c.New))
+	case Removed:
+		return fmt.Sprintf("- %s: %s", path, show(c.Old))
+	default:
+		return fmt.Sprintf("~ %s: %s => %s", path, show(c.Old), show(c.New))
+	}
+}
+
+// Print a value for a patch, strings are quoted so that they can be told apart from other values.
+func show(value any) string {
+	if s, ok := value.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprint(value)
+}
+
+// All the differences between two values, in the order they appear in the values.
+type Patch []Change
+
+// Print the patch, one change per line.
+func (p Patch) String() string {
+	lines := make([]string, len(p))
+	for i, c := range p {
+		lines[i] = c.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Determine if two values are structurally equal.
+func Equal[T any](a, b T) bool {
+	d := differ{firstOnly: true, visited: make(map[visit]bool)}
+	d.diff("", root(a), root(b))
+	return len(d.patch) == 0
+}
+
+// Find every difference between two values.
+// An empty Patch means the values are equal.
+func Diff[T any](a, b T) Patch {
+	d := differ{visited: make(map[visit]bool)}
+	d.diff("", root(a), root(b))
+	return d.patch
+}
+
+// INTERNALS
+
+var (
+	maybeResultPath = reflect.TypeOf(MaybeResult.Maybe[int]{}).PkgPath()
+	setPath         = reflect.TypeOf(Set.Set[int]{}).PkgPath()
+)
+
+func isGeneric(t reflect.Type, pkgPath string, name string) bool {
+	return t.PkgPath() == pkgPath && strings.HasPrefix(t.Name(), name+"[")
+}
+
+// Put the value in an addressable variable, so that its unexported fields can be read.
+// Values of nil interfaces stay invalid.
+func root[T any](value T) reflect.Value {
+	v := reflect.New(reflect.TypeOf(&value).Elem()).Elem()
+	v.Set(reflect.ValueOf(&value).Elem())
+	return v
+}
+
+// Make a value usable with Interface and Call, even when it was read from an unexported field.
+func exported(v reflect.Value) reflect.Value {
+	if v.CanInterface() {
+		return v
+	}
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+}
+
+// Get a field of a struct, making the struct addressable first when needed.
+func field(v reflect.Value, i int) reflect.Value {
+	if !v.CanAddr() {
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		v = c
+	}
+	return exported(v.Field(i))
+}
+
+func call(v reflect.Value, method string) reflect.Value {
+	return v.MethodByName(method).Call(nil)[0]
+}
+
+type visit struct {
+	a, b unsafe.Pointer
+	t    reflect.Type
+}
+
+type differ struct {
+	patch     Patch
+	firstOnly bool
+	visited   map[visit]bool
+}
+
+func (d *differ) done() bool {
+	return d.firstOnly && len(d.patch) > 0
+}
+
+func (d *differ) report(path string, kind Kind, a, b reflect.Value) {
+	c := Change{Path: path, Kind: kind}
+	if kind != Added {
+		c.Old = a.Interface()
+	}
+	if kind != Removed {
+		c.New = b.Interface()
+	}
+	d.patch = append(d.patch, c)
+}
+
+func (d *differ) diff(path string, a, b reflect.Value) {
+	if d.done() {
+		return
+	}
+	t := a.Type()
+	switch {
+	case isGeneric(t, maybeResultPath, "Maybe"):
+		d.diffMaybe(path, a, b)
+		return
+	case isGeneric(t, maybeResultPath, "Result"):
+		d.diffResult(path, a, b)
+		return
+	case isGeneric(t, setPath, "Set"):
+		d.diffSet(path, field(a, 0), field(b, 0))
+		return
+	}
+	switch a.Kind() {
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			d.diff(path+"."+t.Field(i).Name, field(a, i), field(b, i))
+		}
+	case reflect.Slice:
+		if a.IsNil() != b.IsNil() && (a.Len() > 0 || b.Len() > 0) {
+			d.report(path, Changed, a, b)
+			return
+		}
+		d.diffList(path, a, b)
+	case reflect.Array:
+		d.diffList(path, a, b)
+	case reflect.Map:
+		d.diffMap(path, a, b)
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				d.report(path, Changed, a, b)
+			}
+			return
+		}
+		if a.Pointer() == b.Pointer() {
+			return
+		}
+		// Pointers can form cycles, every pair of pointers is only compared once.
+		key := visit{a.UnsafePointer(), b.UnsafePointer(), t}
+		if d.visited[key] {
+			return
+		}
+		d.visited[key] = true
+		d.diff(path, a.Elem(), b.Elem())
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() || a.Elem().Type() != b.Elem().Type() {
+			if a.IsNil() != b.IsNil() || (!a.IsNil() && a.Elem().Type() != b.Elem().Type()) {
+				d.report(path, Changed, a, b)
+			}
+			return
+		}
+		d.diff(path, exported(a).Elem(), exported(b).Elem())
+	case reflect.Func:
+		// Functions are only equal when both are nil, like with `reflect.DeepEqual`.
+		if !a.IsNil() || !b.IsNil() {
+			d.report(path, Changed, a, b)
+		}
+	default:
+		if !equalScalar(a, b) {
+			d.report(path, Changed, a, b)
+		}
+	}
+}
+
+func equalScalar(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return a.Complex() == b.Complex()
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Chan, reflect.UnsafePointer:
+		return a.Pointer() == b.Pointer()
+	default:
+		return a.Interface() == b.Interface()
+	}
+}
+
+// Determine if two values are equal, without reporting anything.
+func (d *differ) equal(a, b reflect.Value) bool {
+	inner := differ{firstOnly: true, visited: make(map[visit]bool)}
+	inner.diff("", a, b)
+	return len(inner.patch) == 0
+}
+
+func (d *differ) diffMaybe(path string, a, b reflect.Value) {
+	aJust, bJust := call(a, "IsJust").Bool(), call(b, "IsJust").Bool()
+	if aJust != bJust {
+		d.report(path, Changed, a, b)
+	} else if aJust {
+		d.diff(path+".Just", call(a, "Expect"), call(b, "Expect"))
+	}
+}
+
+func (d *differ) diffResult(path string, a, b reflect.Value) {
+	aOk, bOk := call(a, "IsOk").Bool(), call(b, "IsOk").Bool()
+	switch {
+	case aOk != bOk:
+		d.report(path, Changed, a, b)
+	case aOk:
+		d.diff(path+".Ok", call(a, "Expect"), call(b, "Expect"))
+	case call(a, "Error").String() != call(b, "Error").String():
+		d.report(path, Changed, a, b)
+	}
+}
+
+// Sort the keys of a map by their printed form, so that reports are deterministic.
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	return keys
+}
+
+func (d *differ) diffMap(path string, a, b reflect.Value) {
+	if a.IsNil() != b.IsNil() && (a.Len() > 0 || b.Len() > 0) {
+		d.report(path, Changed, a, b)
+		return
+	}
+	for _, key := range sortedKeys(a) {
+		keyPath := fmt.Sprintf("%s[%#v]", path, key.Interface())
+		if other := b.MapIndex(key); other.IsValid() {
+			d.diff(keyPath, a.MapIndex(key), other)
+		} else {
+			d.report(keyPath, Removed, a.MapIndex(key), reflect.Value{})
+		}
+	}
+	for _, key := range sortedKeys(b) {
+		if !a.MapIndex(key).IsValid() {
+			d.report(fmt.Sprintf("%s[%#v]", path, key.Interface()), Added, reflect.Value{}, b.MapIndex(key))
+		}
+	}
+}
+
+func (d *differ) diffSet(path string, a, b reflect.Value) {
+	for _, key := range sortedKeys(a) {
+		if !b.MapIndex(key).IsValid() {
+			d.report(path+"{}", Removed, key, reflect.Value{})
+		}
+	}
+	for _, key := range sortedKeys(b) {
+		if !a.MapIndex(key).IsValid() {
+			d.report(path+"{}", Added, reflect.Value{}, key)
+		}
+	}
+}
+
+// Diff two lists along a shortest edit script, as found by `List.DiffWith`.
+// Elements that are only in the first list are removed, elements only in the second list are added.
+// A run of removals directly followed by a run of additions is paired up as changed elements,
+// which are diffed further.
+func (d *differ) diffList(path string, a, b reflect.Value) {
+	edits := List.DiffWith(func(i, j int) bool {
+		return d.equal(a.Index(i), b.Index(j))
+	}, List.Range(0, a.Len()-1), List.Range(0, b.Len()-1))
+	var removed, added []int
+	flush := func() {
+		paired := len(removed)
+		if len(added) < paired {
+			paired = len(added)
+		}
+		for k := 0; k < paired; k++ {
+			d.diff(fmt.Sprintf("%s[%d]", path, removed[k]), a.Index(removed[k]), b.Index(added[k]))
+		}
+		for _, i := range removed[paired:] {
+			d.report(fmt.Sprintf("%s[%d]", path, i), Removed, a.Index(i), reflect.Value{})
+		}
+		for _, j := range added[paired:] {
+			d.report(fmt.Sprintf("%s[%d]", path, j), Added, reflect.Value{}, b.Index(j))
+		}
+		removed, added = removed[:0], added[:0]
+	}
+	for _, edit := range edits {
+		switch edit.Op {
+		case List.EditKeep:
+			flush()
+		case List.EditDelete:
+			removed = append(removed, edit.OldIndex)
+		case List.EditInsert:
+			added = append(added, edit.NewIndex)
+		}
+	}
+	flush()
+}
@@ -0,0 +1,146 @@
+package Deep_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Deep"
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Set"
+	"github.com/manwitha1000names/gofp/v3/Tuple"
+)
+
+type config struct {
+	Name    string
+	Tags    []string
+	Limits  map[string]int
+	Timeout MaybeResult.Maybe[int]
+	secret  int
+}
+
+func TestEqual(t *testing.T) {
+	a := config{Name: "a", Tags: []string{"x"}, Limits: map[string]int{"cpu": 2}, Timeout: MaybeResult.Just(5), secret: 1}
+	b := config{Name: "a", Tags: []string{"x"}, Limits: map[string]int{"cpu": 2}, Timeout: MaybeResult.Just(5), secret: 1}
+	if !Deep.Equal(a, b) {
+		t.Errorf("equal configs differ: %v", Deep.Diff(a, b))
+	}
+	b.secret = 2
+	if Deep.Equal(a, b) {
+		t.Error("unexported fields are not compared")
+	}
+	if !Deep.Equal([]int(nil), []int{}) || !Deep.Equal(map[string]int(nil), map[string]int{}) {
+		t.Error("nil and empty are not equal")
+	}
+	if Deep.Equal([]int(nil), []int{1}) || Deep.Equal(map[string]int(nil), map[string]int{"a": 1}) {
+		t.Error("nil and non-empty are equal")
+	}
+	if !Deep.Equal[any](nil, nil) || Deep.Equal[any](nil, 1) || Deep.Equal[any](1, "1") {
+		t.Error("interfaces")
+	}
+	if !Deep.Equal[func()](nil, nil) || Deep.Equal(TestEqual, TestEqual) {
+		t.Error("functions are only equal when both are nil")
+	}
+}
+
+func TestGofpTypes(t *testing.T) {
+	if !Deep.Equal(MaybeResult.Nothing[[]int](), MaybeResult.Nothing[[]int]()) || Deep.Equal(MaybeResult.Just(1), MaybeResult.Nothing[int]()) {
+		t.Error("Maybe")
+	}
+	// The Results hold different errors with the same message.
+	if !Deep.Equal(MaybeResult.Err[int](errors.New("boom")), MaybeResult.Err[int](errors.New("boom"))) {
+		t.Error("Errs with the same message differ")
+	}
+	if Deep.Equal(MaybeResult.Err[int](errors.New("boom")), MaybeResult.Err[int](errors.New("bang"))) || Deep.Equal(MaybeResult.Ok(0), MaybeResult.Err[int](errors.New("boom"))) {
+		t.Error("different Results are equal")
+	}
+	// Sets holding the same elements are equal, regardless of how they were built.
+	built := Set.Insert(3, Set.Insert(1, Set.Empty[int]()))
+	if !Deep.Equal(built, Set.FromList([]int{3, 1})) || Deep.Equal(built, Set.FromList([]int{1})) {
+		t.Error("Set")
+	}
+}
+
+func TestDiffPaths(t *testing.T) {
+	a := config{Name: "a", Tags: []string{"x"}, Limits: map[string]int{"cpu": 2, "mem": 4}, Timeout: MaybeResult.Just(5)}
+	b := config{Name: "b", Tags: []string{"x"}, Limits: map[string]int{"cpu": 3, "disk": 1}, Timeout: MaybeResult.Just(6)}
+	want := Deep.Patch{
+		{Path: ".Name", Kind: Deep.Changed, Old: "a", New: "b"},
+		{Path: `.Limits["cpu"]`, Kind: Deep.Changed, Old: 2, New: 3},
+		{Path: `.Limits["mem"]`, Kind: Deep.Removed, Old: 4},
+		{Path: `.Limits["disk"]`, Kind: Deep.Added, New: 1},
+		{Path: ".Timeout.Just", Kind: Deep.Changed, Old: 5, New: 6},
+	}
+	if got := Deep.Diff(a, b); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff =\n%v\nwant\n%v", got, want)
+	}
+	if got := Deep.Diff(a, a); len(got) != 0 {
+		t.Errorf("Diff of equal values = %v", got)
+	}
+
+	nested := Deep.Diff(Tuple.Pair(1, MaybeResult.Ok([]int{1})), Tuple.Pair(1, MaybeResult.Ok([]int{2})))
+	if len(nested) != 1 || nested[0].Path != ".Snd.Ok[0]" {
+		t.Errorf("Diff of nested values = %v", nested)
+	}
+
+	sets := Deep.Diff(Set.FromList([]int{1, 2}), Set.FromList([]int{2, 3}))
+	if len(sets) != 2 || sets[0] != (Deep.Change{Path: "{}", Kind: Deep.Removed, Old: 1}) || sets[1] != (Deep.Change{Path: "{}", Kind: Deep.Added, New: 3}) {
+		t.Errorf("Diff of Sets = %v", sets)
+	}
+}
+
+func TestDiffLists(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b []int
+		want Deep.Patch
+	}{
+		{"an insertion", []int{1, 2, 3}, []int{1, 9, 2, 3}, Deep.Patch{
+			{Path: "[1]", Kind: Deep.Added, New: 9},
+		}},
+		{"a replacement and a removal", []int{1, 2, 3, 4}, []int{1, 5, 4}, Deep.Patch{
+			{Path: "[1]", Kind: Deep.Changed, Old: 2, New: 5},
+			{Path: "[2]", Kind: Deep.Removed, Old: 3},
+		}},
+		{"removals at the front", []int{1, 2, 3}, []int{3}, Deep.Patch{
+			{Path: "[0]", Kind: Deep.Removed, Old: 1},
+			{Path: "[1]", Kind: Deep.Removed, Old: 2},
+		}},
+	}
+	for _, c := range cases {
+		if got := Deep.Diff(c.a, c.b); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Diff of %s =\n%v\nwant\n%v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestPatchString(t *testing.T) {
+	patch := Deep.Patch{
+		{Path: ".Name", Kind: Deep.Changed, Old: "a", New: "b"},
+		{Path: "[2]", Kind: Deep.Removed, Old: 4},
+		{Path: "", Kind: Deep.Added, New: 1},
+	}
+	want := "~ .Name: \"a\" => \"b\"\n- [2]: 4\n+ .: 1"
+	if got := patch.String(); got != want {
+		t.Errorf("String =\n%s\nwant\n%s", got, want)
+	}
+}
+
+type node struct {
+	Value int
+	Next  *node
+}
+
+func TestCycles(t *testing.T) {
+	a := &node{Value: 1}
+	a.Next = &node{Value: 2, Next: a}
+	b := &node{Value: 1}
+	b.Next = &node{Value: 2, Next: b}
+	if !Deep.Equal(a, b) {
+		t.Errorf("equal cycles differ: %v", Deep.Diff(a, b))
+	}
+	b.Next.Value = 3
+	if got := Deep.Diff(a, b); len(got) != 1 || got[0].Path != ".Next.Value" {
+		t.Errorf("Diff of cycles = %v", got)
+	}
+}
@@ -1,6 +1,7 @@
 package Dict
 
 import (
+	"github.com/manwitha1000names/gofp/v3/Basics"
 	"github.com/manwitha1000names/gofp/v3/List"
 	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
 	"github.com/manwitha1000names/gofp/v3/Tuple"
@@ -139,6 +140,16 @@ func Foldr[Key comparable, Value any, Acc any](recuder func(key Key, value Value
 	}, init, ToList(m))
 }
 
+// Map every key-value pair to a value of a Monoid and combine all of them, NOT IN ANY PARTICULAR ORDER.
+// Since the order is not defined, the Monoid should also be commutative.
+func MapReduce[Key comparable, Value any, U any](mapfn func(key Key, value Value) U, monoid Basics.Monoid[U], m map[Key]Value) U {
+	acc := monoid.Empty()
+	for key, value := range m {
+		acc = monoid.Combine(acc, mapfn(key, value))
+	}
+	return acc
+}
+
 // Keep only the key-value pairs that pass the given test.
 // This functions is IMMUTABLE and produces a completely new map!
 func Filter[Key comparable, Value any](testfn func(key Key, value Value) bool, m map[Key]Value) map[Key]Value {
@@ -165,6 +176,54 @@ func Partition[Key comparable, Value any](partfn func(key Key, value Value) bool
 	return map1, map2
 }
 
+// EXTREMES
+
+// Find the key-value pair with the largest derived property in a non-empty dictionary.
+// If several pairs tie, any one of them may be returned.
+func MaximumBy[Key comparable, Value any, U Basics.Ordered](mapfn func(key Key, value Value) U, m map[Key]Value) Maybe[Tuple.Tuple[Key, Value]] {
+	return List.MaximumBy(Tuple.Uncurry(mapfn), ToList(m))
+}
+
+// Find the key-value pair with the smallest derived property in a non-empty dictionary.
+// If several pairs tie, any one of them may be returned.
+func MinimumBy[Key comparable, Value any, U Basics.Ordered](mapfn func(key Key, value Value) U, m map[Key]Value) Maybe[Tuple.Tuple[Key, Value]] {
+	return List.MinimumBy(Tuple.Uncurry(mapfn), ToList(m))
+}
+
+// Find the largest key-value pair according to the comparison function in a non-empty dictionary.
+// If several pairs tie, any one of them may be returned.
+func MaximumWith[Key comparable, Value any](cmpfn func(a, b Tuple.Tuple[Key, Value]) Basics.Order, m map[Key]Value) Maybe[Tuple.Tuple[Key, Value]] {
+	return List.MaximumWith(cmpfn, ToList(m))
+}
+
+// Find the smallest key-value pair according to the comparison function in a non-empty dictionary.
+// If several pairs tie, any one of them may be returned.
+func MinimumWith[Key comparable, Value any](cmpfn func(a, b Tuple.Tuple[Key, Value]) Basics.Order, m map[Key]Value) Maybe[Tuple.Tuple[Key, Value]] {
+	return List.MinimumWith(cmpfn, ToList(m))
+}
+
+// Find the keys of all the pairs with the largest derived property, NOT IN ANY PARTICULAR ORDER.
+func MaximumKeysBy[Key comparable, Value any, U Basics.Ordered](mapfn func(key Key, value Value) U, m map[Key]Value) []Key {
+	list := ToList(m)
+	return List.Map(func(index int) Key {
+		return list[index].Fst
+	}, List.MaximumIndicesBy(Tuple.Uncurry(mapfn), list))
+}
+
+// Find the keys of all the pairs with the smallest derived property, NOT IN ANY PARTICULAR ORDER.
+func MinimumKeysBy[Key comparable, Value any, U Basics.Ordered](mapfn func(key Key, value Value) U, m map[Key]Value) []Key {
+	list := ToList(m)
+	return List.Map(func(index int) Key {
+		return list[index].Fst
+	}, List.MinimumIndicesBy(Tuple.Uncurry(mapfn), list))
+}
+
+// Find both the key-value pairs with the smallest and the largest derived property of a non-empty dictionary, in a single pass.
+// If several pairs tie, any one of them may be returned.
+func MinMaxBy[Key comparable, Value any, U Basics.Ordered](mapfn func(key Key, value Value) U, m map[Key]Value) Maybe[Tuple.Tuple[Tuple.Tuple[Key, Value], Tuple.Tuple[Key, Value]]] {
+	return List.MinMaxBy(Tuple.Uncurry(mapfn), ToList(m))
+}
+
 // COMBINE
 
 // Combine two dictionaries. If there is a collision, preference is given to the first dictionary.
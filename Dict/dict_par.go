@@ -1,6 +1,12 @@
 package Dict
 
-import "sync"
+import (
+	"sync"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	"github.com/manwitha1000names/gofp/v3/List"
+	"github.com/manwitha1000names/gofp/v3/Tuple"
+)
 
 // TRANSFORM
 
@@ -58,3 +64,13 @@ func Partition_par[Key comparable, Value any](partfn func(key Key, value Value)
 	wg.Wait()
 	return map1, map2
 }
+
+// REDUCE
+
+// Map every key-value pair to a value of a Monoid and combine all of them, in parallel.
+// Since the order is not defined, the Monoid should also be commutative.
+func MapReduce_par[Key comparable, Value any, U any](mapfn func(key Key, value Value) U, monoid Basics.Monoid[U], m map[Key]Value) U {
+	return List.MapReduce_par(func(t Tuple.Tuple[Key, Value]) U {
+		return mapfn(t.Fst, t.Snd)
+	}, monoid, ToList(m))
+}
@@ -0,0 +1,24 @@
+package Dict_test
+
+import (
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	"github.com/manwitha1000names/gofp/v3/Dict"
+)
+
+func TestMapReduce_par(t *testing.T) {
+	m := make(map[int]int, 10_000)
+	want := 0
+	for i := 0; i < 10_000; i++ {
+		m[i] = i * 2
+		want += i + i*2
+	}
+	got := Dict.MapReduce_par(func(key int, value int) int { return key + value }, Basics.SumMonoid[int](), m)
+	if got != want {
+		t.Errorf("MapReduce_par = %d, want %d", got, want)
+	}
+	if got := Dict.MapReduce_par(func(key int, value int) int { return key }, Basics.ProductMonoid[int](), map[int]int{}); got != 1 {
+		t.Errorf("MapReduce_par of an empty Dict = %d, want the identity 1", got)
+	}
+}
@@ -0,0 +1,55 @@
+package Dict_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	"github.com/manwitha1000names/gofp/v3/Dict"
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Tuple"
+)
+
+func TestExtremes(t *testing.T) {
+	m := map[string]int{"a": 3, "b": 9, "c": 1, "d": 5}
+	value := func(key string, value int) int { return value }
+	byValue := func(a, b Tuple.Tuple[string, int]) Basics.Order { return Basics.Compare(a.Snd, b.Snd) }
+
+	if got := Dict.MaximumBy(value, m); got != Just(Tuple.Pair("b", 9)) {
+		t.Errorf("MaximumBy = %v", got)
+	}
+	if got := Dict.MinimumBy(value, m); got != Just(Tuple.Pair("c", 1)) {
+		t.Errorf("MinimumBy = %v", got)
+	}
+	if got := Dict.MaximumWith(byValue, m); got != Just(Tuple.Pair("b", 9)) {
+		t.Errorf("MaximumWith = %v", got)
+	}
+	if got := Dict.MinimumWith(byValue, m); got != Just(Tuple.Pair("c", 1)) {
+		t.Errorf("MinimumWith = %v", got)
+	}
+	if got := Dict.MinMaxBy(value, m); got != Just(Tuple.Pair(Tuple.Pair("c", 1), Tuple.Pair("b", 9))) {
+		t.Errorf("MinMaxBy = %v", got)
+	}
+
+	empty := map[string]int{}
+	if Dict.MaximumBy(value, empty).IsJust() || Dict.MinimumWith(byValue, empty).IsJust() || Dict.MinMaxBy(value, empty).IsJust() {
+		t.Error("the extremes of an empty Dict are not Nothing")
+	}
+}
+
+func TestExtremeKeys(t *testing.T) {
+	m := map[string]int{"a": 3, "b": 9, "c": 1, "d": 9, "e": 1}
+	value := func(key string, value int) int { return value }
+	maximums, minimums := Dict.MaximumKeysBy(value, m), Dict.MinimumKeysBy(value, m)
+	sort.Strings(maximums)
+	sort.Strings(minimums)
+	if len(maximums) != 2 || maximums[0] != "b" || maximums[1] != "d" {
+		t.Errorf("MaximumKeysBy = %v", maximums)
+	}
+	if len(minimums) != 2 || minimums[0] != "c" || minimums[1] != "e" {
+		t.Errorf("MinimumKeysBy = %v", minimums)
+	}
+	if got := Dict.MaximumKeysBy(value, map[string]int{}); len(got) != 0 {
+		t.Errorf("MaximumKeysBy of an empty Dict = %v", got)
+	}
+}
@@ -0,0 +1,211 @@
+package Either
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
+// Represents a value that is one of two possibilities, a Left or a Right.
+// Unlike `Result` neither side has to be an error,
+// both sides are ordinary domain values.
+type Either[L, R any] struct {
+	isRight bool
+	left    L
+	right   R
+}
+
+// CONSTRUCTION
+
+// Create the 'Left' variant of the Either type.
+func Left[L, R any](value L) Either[L, R] {
+	return Either[L, R]{left: value}
+}
+
+// Create the 'Right' variant of the Either type.
+func Right[L, R any](value R) Either[L, R] {
+	return Either[L, R]{isRight: true, right: value}
+}
+
+// Create an `Either` from a `Result`, the error becomes the Left value.
+//
+//	Ok(T)    => Right(T)
+//	Err(err) => Left(err)
+func FromResult[R any](r MaybeResult.Result[R]) Either[error, R] {
+	return MaybeResult.Fold(r, Right[error, R], Left[error, R])
+}
+
+// Create an `Either` from a `Maybe`, using the given value when there is Nothing.
+//
+//	Just(R) => Right(R)
+//	Nothing => Left(left)
+func FromMaybe[L, R any](left L, m MaybeResult.Maybe[R]) Either[L, R] {
+	return MaybeResult.Match(m, Right[L, R], func() Either[L, R] {
+		return Left[L, R](left)
+	})
+}
+
+// METHODS
+
+// Detect wherether the Either is the 'Left' variant.
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+// Detect wherether the Either is the 'Right' variant.
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// Get the Left value if there is one.
+//
+//	Left(L)  => Just(L)
+//	Right(R) => Nothing
+func (e Either[L, R]) Left() MaybeResult.Maybe[L] {
+	if e.isRight {
+		return MaybeResult.Nothing[L]()
+	}
+	return MaybeResult.Just(e.left)
+}
+
+// Get the Right value if there is one.
+//
+//	Left(L)  => Nothing
+//	Right(R) => Just(R)
+func (e Either[L, R]) Right() MaybeResult.Maybe[R] {
+	if e.isRight {
+		return MaybeResult.Just(e.right)
+	}
+	return MaybeResult.Nothing[R]()
+}
+
+// Convert the `Either` into a `Maybe`, keeping only the Right value.
+func (e Either[L, R]) ToMaybe() MaybeResult.Maybe[R] {
+	return e.Right()
+}
+
+// TRANSFORM
+
+// Transform the Left value, leaving a Right untouched.
+//
+//	Left(L)  => Left(fn(L))
+//	Right(R) => Right(R)
+func MapLeft[L, R, U any](mapfn func(value L) U, e Either[L, R]) Either[U, R] {
+	if e.isRight {
+		return Right[U](e.right)
+	}
+	return Left[U, R](mapfn(e.left))
+}
+
+// Transform the Right value, leaving a Left untouched.
+//
+//	Left(L)  => Left(L)
+//	Right(R) => Right(fn(R))
+func MapRight[L, R, U any](mapfn func(value R) U, e Either[L, R]) Either[L, U] {
+	if e.isRight {
+		return Right[L](mapfn(e.right))
+	}
+	return Left[L, U](e.left)
+}
+
+// Transform whichever value is present.
+//
+//	Left(L)  => Left(mapfnL(L))
+//	Right(R) => Right(mapfnR(R))
+func BiMap[L, R, U, V any](mapfnL func(value L) U, mapfnR func(value R) V, e Either[L, R]) Either[U, V] {
+	if e.isRight {
+		return Right[U](mapfnR(e.right))
+	}
+	return Left[U, V](mapfnL(e.left))
+}
+
+// Chain together computations on the Right value.
+//
+//	Left(L)  => Left(L)
+//	Right(R) => fn(R)
+func AndThen[L, R, U any](fn func(value R) Either[L, U], e Either[L, R]) Either[L, U] {
+	if e.isRight {
+		return fn(e.right)
+	}
+	return Left[L, U](e.left)
+}
+
+// Exhaustively match on an `Either`, producing a value of any type.
+//
+//	Left(L)  => onLeft(L)
+//	Right(R) => onRight(R)
+func Fold[L, R, U any](onLeft func(value L) U, onRight func(value R) U, e Either[L, R]) U {
+	if e.isRight {
+		return onRight(e.right)
+	}
+	return onLeft(e.left)
+}
+
+// Swap the sides of an `Either`.
+//
+//	Left(L)  => Right(L)
+//	Right(R) => Left(R)
+func Swap[L, R any](e Either[L, R]) Either[R, L] {
+	if e.isRight {
+		return Left[R, L](e.right)
+	}
+	return Right[R](e.left)
+}
+
+// Convert the `Either` into a `Result`, turning the Left value into an error with the given function.
+//
+//	Left(L)  => Err(errfn(L))
+//	Right(R) => Ok(R)
+func ToResult[L, R any](errfn func(value L) error, e Either[L, R]) MaybeResult.Result[R] {
+	if e.isRight {
+		return MaybeResult.Ok(e.right)
+	}
+	return MaybeResult.Err[R](errfn(e.left))
+}
+
+// INTERFACE IMPLEMENTATIONS
+
+func (e Either[L, R]) Format(f fmt.State, c rune) {
+	if e.isRight {
+		_, _ = f.Write([]byte("Right(" + fmt.Sprint(e.right) + ")"))
+	} else {
+		_, _ = f.Write([]byte("Left(" + fmt.Sprint(e.left) + ")"))
+	}
+}
+
+// Encode the Either as {"Left": value} or {"Right": value}.
+func (e Either[L, R]) MarshalJSON() ([]byte, error) {
+	if e.isRight {
+		return json.Marshal(map[string]R{"Right": e.right})
+	}
+	return json.Marshal(map[string]L{"Left": e.left})
+}
+
+// Decode an Either from {"Left": value} or {"Right": value}.
+func (e *Either[L, R]) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	left, isLeft := raw["Left"]
+	right, isRight := raw["Right"]
+	if len(raw) != 1 || isLeft == isRight {
+		return errors.New("Either must be an object with exactly one key, `Left` or `Right`.")
+	}
+	if isRight {
+		var value R
+		if err := json.Unmarshal(right, &value); err != nil {
+			return err
+		}
+		*e = Right[L](value)
+		return nil
+	}
+	var value L
+	if err := json.Unmarshal(left, &value); err != nil {
+		return err
+	}
+	*e = Left[L, R](value)
+	return nil
+}
@@ -0,0 +1,145 @@
+package Either_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Either"
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
+type E = Either.Either[string, int]
+
+func TestVariants(t *testing.T) {
+	l := Either.Left[string, int]("no")
+	r := Either.Right[string](4)
+	if !l.IsLeft() || l.IsRight() || l.Left().WithDefault("") != "no" || l.Right().IsJust() {
+		t.Errorf("Left is wrong: %v", l)
+	}
+	if !r.IsRight() || r.IsLeft() || r.Right().WithDefault(0) != 4 || r.Left().IsJust() {
+		t.Errorf("Right is wrong: %v", r)
+	}
+	if r.ToMaybe().WithDefault(0) != 4 || l.ToMaybe().IsJust() {
+		t.Error("ToMaybe does not keep only the Right value")
+	}
+}
+
+func TestFromResultAndFromMaybe(t *testing.T) {
+	if e := Either.FromResult(MaybeResult.Ok(1)); e != Either.Right[error](1) {
+		t.Errorf("FromResult(Ok(1)) = %v", e)
+	}
+	if e := Either.FromResult(MaybeResult.Err[int](io.EOF)); e != Either.Left[error, int](io.EOF) {
+		t.Errorf("FromResult(Err(EOF)) = %v", e)
+	}
+	if e := Either.FromMaybe("missing", MaybeResult.Just(2)); e != Either.Right[string](2) {
+		t.Errorf("FromMaybe(Just(2)) = %v", e)
+	}
+	if e := Either.FromMaybe("missing", MaybeResult.Nothing[int]()); e != Either.Left[string, int]("missing") {
+		t.Errorf("FromMaybe(Nothing) = %v", e)
+	}
+}
+
+func TestTransform(t *testing.T) {
+	l := Either.Left[string, int]("ab")
+	r := Either.Right[string](3)
+	length := func(s string) int { return len(s) }
+	double := func(n int) int { return n * 2 }
+
+	if got := Either.MapLeft(length, l); got != Either.Left[int, int](2) {
+		t.Errorf("MapLeft(Left) = %v", got)
+	}
+	if got := Either.MapLeft(length, r); got != Either.Right[int](3) {
+		t.Errorf("MapLeft(Right) = %v", got)
+	}
+	if got := Either.MapRight(double, r); got != Either.Right[string](6) {
+		t.Errorf("MapRight(Right) = %v", got)
+	}
+	if got := Either.MapRight(double, l); got != l {
+		t.Errorf("MapRight(Left) = %v", got)
+	}
+	if got := Either.BiMap(length, double, l); got != Either.Left[int, int](2) {
+		t.Errorf("BiMap(Left) = %v", got)
+	}
+	if got := Either.BiMap(length, double, r); got != Either.Right[int](6) {
+		t.Errorf("BiMap(Right) = %v", got)
+	}
+	if got := Either.Swap(r); got != Either.Left[int, string](3) {
+		t.Errorf("Swap(Right) = %v", got)
+	}
+}
+
+func TestAndThen(t *testing.T) {
+	half := func(n int) E {
+		if n%2 != 0 {
+			return Either.Left[string, int](strconv.Itoa(n) + " is odd")
+		}
+		return Either.Right[string](n / 2)
+	}
+	cases := []struct {
+		in, want E
+	}{
+		{Either.Right[string](8), Either.Right[string](4)},
+		{Either.Right[string](3), Either.Left[string, int]("3 is odd")},
+		{Either.Left[string, int]("earlier"), Either.Left[string, int]("earlier")},
+	}
+	for _, c := range cases {
+		if got := Either.AndThen(half, c.in); got != c.want {
+			t.Errorf("AndThen(%v) = %v, want %v", c.in, got, c.want)
+		}
+	}
+}
+
+func TestFoldAndToResult(t *testing.T) {
+	describe := func(e E) string {
+		return Either.Fold(func(s string) string { return "left " + s }, func(n int) string { return "right " + strconv.Itoa(n) }, e)
+	}
+	if got := describe(Either.Left[string, int]("x")); got != "left x" {
+		t.Errorf("Fold(Left) = %q", got)
+	}
+	if got := describe(Either.Right[string](1)); got != "right 1" {
+		t.Errorf("Fold(Right) = %q", got)
+	}
+
+	toErr := func(s string) error { return errors.New(s) }
+	if r := Either.ToResult(toErr, Either.Right[string](1)); r.Expect() != 1 {
+		t.Errorf("ToResult(Right) = %v", r)
+	}
+	if r := Either.ToResult(toErr, Either.Left[string, int]("bad")); r.IsOk() || r.Unwrap().Error() != "bad" {
+		t.Errorf("ToResult(Left) = %v", r)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	if got := fmt.Sprint(Either.Left[string, int]("x")); got != "Left(x)" {
+		t.Errorf("Sprint(Left) = %q", got)
+	}
+	if got := fmt.Sprint(Either.Right[string](1)); got != "Right(1)" {
+		t.Errorf("Sprint(Right) = %q", got)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	for _, e := range []E{Either.Left[string, int]("x"), Either.Right[string](1)} {
+		data, err := json.Marshal(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var back E
+		if err := json.Unmarshal(data, &back); err != nil || back != e {
+			t.Errorf("round trip of %v through %s = %v, %v", e, data, back, err)
+		}
+	}
+	if data, _ := json.Marshal(Either.Right[string](1)); string(data) != `{"Right":1}` {
+		t.Errorf("Marshal(Right(1)) = %s", data)
+	}
+	for _, bad := range []string{`{}`, `{"Left":"x","Right":1}`, `{"Other":1}`, `[1]`, `{"Right":"not a number"}`} {
+		var e E
+		if err := json.Unmarshal([]byte(bad), &e); err == nil {
+			t.Errorf("Unmarshal(%s) succeeded with %v", bad, e)
+		}
+	}
+}
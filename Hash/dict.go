@@ -0,0 +1,164 @@
+package Hash
+
+import (
+	"hash/maphash"
+
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Tuple"
+)
+
+// A mutable dictionary whose keys are compared and hashed with a `Hasher`,
+// so that the keys do not have to be `comparable`.
+// Do not change a key while it is in the Dict, that would change its hash.
+//
+// Create a Dict with `NewDict` or `DictFromList`.
+type Dict[K, V any] struct {
+	hasher  Hasher[K]
+	seed    maphash.Seed
+	buckets map[uint64][]Tuple.Tuple[K, V]
+	size    int
+}
+
+// CONSTRUCTION
+
+// Create an empty Dict whose keys use the Hasher.
+func NewDict[K, V any](hasher Hasher[K]) *Dict[K, V] {
+	return &Dict[K, V]{
+		hasher:  hasher,
+		seed:    maphash.MakeSeed(),
+		buckets: make(map[uint64][]Tuple.Tuple[K, V]),
+	}
+}
+
+// Create a Dict whose keys use the Hasher from an association list.
+// If a key appears many times, the last value wins.
+func DictFromList[K, V any](hasher Hasher[K], list []Tuple.Tuple[K, V]) *Dict[K, V] {
+	d := NewDict[K, V](hasher)
+	for _, pair := range list {
+		d.Insert(pair.Fst, pair.Snd)
+	}
+	return d
+}
+
+// METHODS
+
+// Find the position of the key in its bucket, or -1 if it is not there.
+func (d *Dict[K, V]) find(key K) (uint64, int) {
+	hash := Sum64(d.hasher, d.seed, key)
+	for i, pair := range d.buckets[hash] {
+		if d.hasher.Equal(pair.Fst, key) {
+			return hash, i
+		}
+	}
+	return hash, -1
+}
+
+// Determine the number of key-value pairs in the Dict.
+func (d *Dict[K, V]) Len() int {
+	return d.size
+}
+
+// Determine if the Dict is empty.
+func (d *Dict[K, V]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// Determine if a key is in the Dict.
+func (d *Dict[K, V]) Member(key K) bool {
+	_, i := d.find(key)
+	return i >= 0
+}
+
+// Get the value associated with a key.
+func (d *Dict[K, V]) Get(key K) MaybeResult.Maybe[V] {
+	hash, i := d.find(key)
+	if i < 0 {
+		return MaybeResult.Nothing[V]()
+	}
+	return MaybeResult.Just(d.buckets[hash][i].Snd)
+}
+
+// Insert a key-value pair. Replaces the value when there is a collision.
+func (d *Dict[K, V]) Insert(key K, value V) {
+	hash, i := d.find(key)
+	if i >= 0 {
+		d.buckets[hash][i].Snd = value
+		return
+	}
+	d.buckets[hash] = append(d.buckets[hash], Tuple.Pair(key, value))
+	d.size++
+}
+
+// Update the value of a key using the given function.
+// The function gets Nothing if the key is not in the Dict, returning Nothing removes the key.
+func (d *Dict[K, V]) Update(key K, upfn func(value MaybeResult.Maybe[V]) MaybeResult.Maybe[V]) {
+	next := upfn(d.Get(key))
+	if next.IsJust() {
+		d.Insert(key, next.Expect())
+	} else {
+		d.Remove(key)
+	}
+}
+
+// Remove a key-value pair, returning the value if the key was in the Dict.
+func (d *Dict[K, V]) Remove(key K) MaybeResult.Maybe[V] {
+	hash, i := d.find(key)
+	if i < 0 {
+		return MaybeResult.Nothing[V]()
+	}
+	bucket := d.buckets[hash]
+	value := bucket[i].Snd
+	if len(bucket) == 1 {
+		delete(d.buckets, hash)
+	} else {
+		bucket[i] = bucket[len(bucket)-1]
+		d.buckets[hash] = bucket[:len(bucket)-1]
+	}
+	d.size--
+	return MaybeResult.Just(value)
+}
+
+// Create a copy of the Dict, changes to one of them do not affect the other.
+func (d *Dict[K, V]) Clone() *Dict[K, V] {
+	c := &Dict[K, V]{
+		hasher:  d.hasher,
+		seed:    d.seed,
+		buckets: make(map[uint64][]Tuple.Tuple[K, V], len(d.buckets)),
+		size:    d.size,
+	}
+	for hash, bucket := range d.buckets {
+		c.buckets[hash] = append([]Tuple.Tuple[K, V](nil), bucket...)
+	}
+	return c
+}
+
+// Get all of the keys in the Dict, NOT IN ANY PARTICULAR ORDER.
+func (d *Dict[K, V]) Keys() []K {
+	keys := make([]K, 0, d.size)
+	for _, bucket := range d.buckets {
+		for _, pair := range bucket {
+			keys = append(keys, pair.Fst)
+		}
+	}
+	return keys
+}
+
+// Get all of the values in the Dict, NOT IN ANY PARTICULAR ORDER.
+func (d *Dict[K, V]) Values() []V {
+	values := make([]V, 0, d.size)
+	for _, bucket := range d.buckets {
+		for _, pair := range bucket {
+			values = append(values, pair.Snd)
+		}
+	}
+	return values
+}
+
+// Convert the Dict into an association list of key-value pairs, NOT IN ANY PARTICULAR ORDER.
+func (d *Dict[K, V]) ToList() []Tuple.Tuple[K, V] {
+	list := make([]Tuple.Tuple[K, V], 0, d.size)
+	for _, bucket := range d.buckets {
+		list = append(list, bucket...)
+	}
+	return list
+}
@@ -0,0 +1,62 @@
+package Hash_test
+
+import (
+	"hash/maphash"
+	"sort"
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Hash"
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Tuple"
+)
+
+func TestDict(t *testing.T) {
+	d := Hash.NewDict[[]int, string](Hash.Slice(Hash.Int[int]()))
+	if !d.IsEmpty() || d.Get([]int{}).IsJust() {
+		t.Fatal("a new Dict is not empty")
+	}
+	d.Insert([]int{1, 2}, "a")
+	d.Insert(nil, "empty")
+	d.Insert([]int{1, 2}, "b")
+	if d.Len() != 2 || d.Get([]int{1, 2}) != MaybeResult.Just("b") || d.Get([]int{}) != MaybeResult.Just("empty") || d.Member([]int{2, 1}) {
+		t.Errorf("the Dict holds %v", d.ToList())
+	}
+
+	appendX := func(value MaybeResult.Maybe[string]) MaybeResult.Maybe[string] {
+		return MaybeResult.Just(value.WithDefault("") + "x")
+	}
+	d.Update([]int{1, 2}, appendX)
+	d.Update([]int{3}, appendX)
+	if d.Get([]int{1, 2}) != MaybeResult.Just("bx") || d.Get([]int{3}) != MaybeResult.Just("x") {
+		t.Errorf("after Update the Dict holds %v", d.ToList())
+	}
+	c := d.Clone()
+	d.Update([]int{3}, func(MaybeResult.Maybe[string]) MaybeResult.Maybe[string] { return MaybeResult.Nothing[string]() })
+	if d.Member([]int{3}) || d.Len() != 2 {
+		t.Error("Update returning Nothing did not remove the key")
+	}
+	if d.Remove([]int{1, 2}) != MaybeResult.Just("bx") || d.Remove([]int{1, 2}).IsJust() {
+		t.Error("Remove")
+	}
+	if c.Len() != 3 || c.Get([]int{1, 2}) != MaybeResult.Just("bx") {
+		t.Error("changing the Dict changed its Clone")
+	}
+
+	values := c.Values()
+	sort.Strings(values)
+	if len(c.Keys()) != 3 || len(values) != 3 || values[0] != "bx" || values[1] != "empty" || values[2] != "x" {
+		t.Errorf("Keys = %v, Values = %v", c.Keys(), values)
+	}
+}
+
+func TestDictFromListCollisions(t *testing.T) {
+	colliding := Hash.New(func(a, b string) bool { return a == b }, func(h *maphash.Hash, value string) {})
+	d := Hash.DictFromList(colliding, []Tuple.Tuple[string, int]{Tuple.Pair("a", 1), Tuple.Pair("b", 2), Tuple.Pair("a", 3), Tuple.Pair("c", 4)})
+	if d.Len() != 3 || d.Get("a") != MaybeResult.Just(3) || d.Get("c") != MaybeResult.Just(4) {
+		t.Errorf("the Dict holds %v", d.ToList())
+	}
+	d.Remove("a")
+	if d.Get("b") != MaybeResult.Just(2) || d.Get("c") != MaybeResult.Just(4) || d.Member("a") {
+		t.Errorf("after Remove the Dict holds %v", d.ToList())
+	}
+}
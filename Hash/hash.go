@@ -0,0 +1,247 @@
+// Equality and hashing for values that are not `comparable`, such as slices, maps and the structs holding them.
+//
+// An `Eq` decides whether two values are equal, a `Hasher` also hashes them.
+// Instances for composite types are built from the instances of their parts,
+// for example `Slice(Slice(String()))` hashes a [][]string.
+// The hashed `Set` and `Dict` containers use a Hasher instead of Go's built-in map keys.
+package Hash
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Tuple"
+)
+
+// Decides whether two values are equal.
+// Equal has to be reflexive, symmetric and transitive.
+type Eq[T any] interface {
+	Equal(a, b T) bool
+}
+
+// Decides whether two values are equal, and hashes them.
+// Values that are Equal MUST write the same bytes into the hash.
+type Hasher[T any] interface {
+	Eq[T]
+	Hash(h *maphash.Hash, value T)
+}
+
+type hasher[T any] struct {
+	equal func(a, b T) bool
+	hash  func(h *maphash.Hash, value T)
+}
+
+func (e hasher[T]) Equal(a, b T) bool {
+	return e.equal(a, b)
+}
+
+func (e hasher[T]) Hash(h *maphash.Hash, value T) {
+	e.hash(h, value)
+}
+
+// Create a Hasher from an equality function and a hash function that agree with each other.
+func New[T any](equal func(a, b T) bool, hash func(h *maphash.Hash, value T)) Hasher[T] {
+	return hasher[T]{equal, hash}
+}
+
+// Hash a value into a number, with the given seed.
+func Sum64[T any](hasher Hasher[T], seed maphash.Seed, value T) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	hasher.Hash(&h, value)
+	return h.Sum64()
+}
+
+func writeUint64(h *maphash.Hash, n uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], n)
+	h.Write(buf[:])
+}
+
+// BASIC INSTANCES
+
+// Compare and hash strings.
+func String() Hasher[string] {
+	return New(Basics.Eq[string], func(h *maphash.Hash, value string) {
+		h.WriteString(value)
+		// Terminate the string, so that ("ab", "c") and ("a", "bc") hash differently.
+		h.WriteByte(0)
+	})
+}
+
+// Compare and hash integers.
+func Int[T Basics.Int]() Hasher[T] {
+	return New(Basics.Eq[T], func(h *maphash.Hash, value T) {
+		writeUint64(h, uint64(value))
+	})
+}
+
+// Compare and hash floats, 0.0 and -0.0 are equal.
+// Unlike with ==, every NaN is equal to every other NaN, so that Equal stays reflexive.
+func Float[T Basics.Float]() Hasher[T] {
+	return New(func(a, b T) bool {
+		return a == b || (a != a && b != b)
+	}, func(h *maphash.Hash, value T) {
+		bits := math.Float64bits(float64(value))
+		if value == 0 {
+			bits = 0
+		} else if value != value {
+			bits = math.Float64bits(math.NaN())
+		}
+		writeUint64(h, bits)
+	})
+}
+
+// COMPOSITE INSTANCES
+
+// Compare and hash slices element by element. A nil slice is equal to an empty slice.
+func Slice[T any](elem Hasher[T]) Hasher[[]T] {
+	return New(func(a, b []T) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !elem.Equal(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	}, func(h *maphash.Hash, value []T) {
+		writeUint64(h, uint64(len(value)))
+		for _, v := range value {
+			elem.Hash(h, v)
+		}
+	})
+}
+
+// Compare and hash maps by their entries, regardless of their order. A nil map is equal to an empty map.
+// Keys are compared and hashed with the key Hasher, the values with the value Hasher.
+// A key that is not found with == is searched for with the key Hasher, which takes O(n) time.
+func Map[K comparable, V any](key Hasher[K], value Hasher[V]) Hasher[map[K]V] {
+	return New(func(a, b map[K]V) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for ka, va := range a {
+			if vb, ok := b[ka]; ok {
+				if !value.Equal(va, vb) {
+					return false
+				}
+				continue
+			}
+			found := false
+			for kb, vb := range b {
+				if key.Equal(ka, kb) && value.Equal(va, vb) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}, func(h *maphash.Hash, m map[K]V) {
+		// Every entry is hashed on its own and the hashes are added up, which does not depend on the order.
+		seed := h.Seed()
+		var sum uint64
+		for k, v := range m {
+			var entry maphash.Hash
+			entry.SetSeed(seed)
+			key.Hash(&entry, k)
+			value.Hash(&entry, v)
+			sum += entry.Sum64()
+		}
+		writeUint64(h, uint64(len(m)))
+		writeUint64(h, sum)
+	})
+}
+
+// Compare and hash 2-tuples part by part.
+func Pair[T, U any](fst Hasher[T], snd Hasher[U]) Hasher[Tuple.Tuple[T, U]] {
+	return New(func(a, b Tuple.Tuple[T, U]) bool {
+		return fst.Equal(a.Fst, b.Fst) && snd.Equal(a.Snd, b.Snd)
+	}, func(h *maphash.Hash, value Tuple.Tuple[T, U]) {
+		fst.Hash(h, value.Fst)
+		snd.Hash(h, value.Snd)
+	})
+}
+
+// Compare and hash 3-tuples part by part.
+func Triple[T, U, V any](fst Hasher[T], snd Hasher[U], thd Hasher[V]) Hasher[Tuple.Tuple3[T, U, V]] {
+	return New(func(a, b Tuple.Tuple3[T, U, V]) bool {
+		return fst.Equal(a.Fst, b.Fst) && snd.Equal(a.Snd, b.Snd) && thd.Equal(a.Thd, b.Thd)
+	}, func(h *maphash.Hash, value Tuple.Tuple3[T, U, V]) {
+		fst.Hash(h, value.Fst)
+		snd.Hash(h, value.Snd)
+		thd.Hash(h, value.Thd)
+	})
+}
+
+// Compare and hash a `Maybe`. Two `Nothing`s are equal, two `Just`s are equal when their values are.
+func Maybe[T any](elem Hasher[T]) Hasher[MaybeResult.Maybe[T]] {
+	return New(func(a, b MaybeResult.Maybe[T]) bool {
+		if a.IsJust() != b.IsJust() {
+			return false
+		}
+		return a.IsNothing() || elem.Equal(a.Expect(), b.Expect())
+	}, func(h *maphash.Hash, value MaybeResult.Maybe[T]) {
+		if value.IsNothing() {
+			h.WriteByte(0)
+			return
+		}
+		h.WriteByte(1)
+		elem.Hash(h, value.Expect())
+	})
+}
+
+// Compare and hash a `Result`. Two `Ok`s are equal when their values are,
+// two `Err`s are equal when their error messages are.
+func Result[T any](elem Hasher[T]) Hasher[MaybeResult.Result[T]] {
+	return New(func(a, b MaybeResult.Result[T]) bool {
+		if a.IsOk() != b.IsOk() {
+			return false
+		}
+		if a.IsErr() {
+			return a.Unwrap().Error() == b.Unwrap().Error()
+		}
+		return elem.Equal(a.Expect(), b.Expect())
+	}, func(h *maphash.Hash, value MaybeResult.Result[T]) {
+		if value.IsErr() {
+			h.WriteByte(0)
+			h.WriteString(value.Unwrap().Error())
+			return
+		}
+		h.WriteByte(1)
+		elem.Hash(h, value.Expect())
+	})
+}
+
+// LISTS
+
+// Figure out whether a list contains a value, according to the Eq.
+func Member[T any, E Eq[T]](eq E, value T, list []T) bool {
+	for _, v := range list {
+		if eq.Equal(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove duplicate elements according to the Hasher, keeping the first occurrence of each.
+// Takes O(n) time on average.
+// This functions is IMMUTABLE and produces a completely new list!
+func Unique[T any](hasher Hasher[T], list []T) []T {
+	seen := NewSet(hasher)
+	new_list := make([]T, 0, len(list))
+	for _, v := range list {
+		if seen.Insert(v) {
+			new_list = append(new_list, v)
+		}
+	}
+	return new_list
+}
@@ -0,0 +1,113 @@
+package Hash_test
+
+import (
+	"errors"
+	"hash/maphash"
+	"math"
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Hash"
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Tuple"
+)
+
+var seed = maphash.MakeSeed()
+
+// Check that the Hasher finds a and b equal or not, and that equal values hash the same.
+func assertEqualIs[T any](t *testing.T, name string, hasher Hash.Hasher[T], a, b T, want bool) {
+	t.Helper()
+	if got := hasher.Equal(a, b); got != want {
+		t.Errorf("%s: Equal(%v, %v) = %v, want %v", name, a, b, got, want)
+	}
+	if got := hasher.Equal(b, a); got != want {
+		t.Errorf("%s: Equal(%v, %v) = %v, want %v", name, b, a, got, want)
+	}
+	if want && Hash.Sum64(hasher, seed, a) != Hash.Sum64(hasher, seed, b) {
+		t.Errorf("%s: %v and %v are equal but hash differently", name, a, b)
+	}
+}
+
+func TestBasicInstances(t *testing.T) {
+	assertEqualIs(t, "String", Hash.String(), "a", "a", true)
+	assertEqualIs(t, "String", Hash.String(), "a", "b", false)
+	assertEqualIs(t, "Int", Hash.Int[int](), 3, 3, true)
+	assertEqualIs(t, "Int", Hash.Int[int8](), -1, 1, false)
+
+	if Hash.Sum64(Hash.Slice(Hash.String()), seed, []string{"ab", "c"}) == Hash.Sum64(Hash.Slice(Hash.String()), seed, []string{"a", "bc"}) {
+		t.Error(`["ab" "c"] and ["a" "bc"] hash the same`)
+	}
+}
+
+func TestFloat(t *testing.T) {
+	float := Hash.Float[float64]()
+	nan := math.NaN()
+	otherNaN := math.Float64frombits(math.Float64bits(nan) ^ 1)
+	assertEqualIs(t, "Float", float, 1.5, 1.5, true)
+	assertEqualIs(t, "Float", float, 1.5, 2.5, false)
+	assertEqualIs(t, "Float zeros", float, 0.0, math.Copysign(0, -1), true)
+	assertEqualIs(t, "Float NaN", float, nan, nan, true)
+	assertEqualIs(t, "Float NaNs with other bits", float, nan, otherNaN, true)
+	assertEqualIs(t, "Float NaN and a number", float, nan, 0, false)
+	assertEqualIs(t, "Float infinities", float, math.Inf(1), math.Inf(-1), false)
+	assertEqualIs(t, "Float32 NaN", Hash.Float[float32](), float32(nan), float32(nan), true)
+}
+
+func TestCompositeInstances(t *testing.T) {
+	slices := Hash.Slice(Hash.Int[int]())
+	assertEqualIs(t, "Slice", slices, []int{1, 2}, []int{1, 2}, true)
+	assertEqualIs(t, "Slice of a different order", slices, []int{1, 2}, []int{2, 1}, false)
+	assertEqualIs(t, "Slice of a different length", slices, []int{1}, []int{1, 1}, false)
+	assertEqualIs(t, "Slice nil and empty", slices, nil, []int{}, true)
+	assertEqualIs(t, "Slice of slices", Hash.Slice(Hash.Slice(Hash.String())), [][]string{{"a"}, {}}, [][]string{{"a"}, nil}, true)
+
+	pairs := Hash.Pair(Hash.String(), Hash.Slice(Hash.Int[int]()))
+	assertEqualIs(t, "Pair", pairs, Tuple.Pair("a", []int{1}), Tuple.Pair("a", []int{1}), true)
+	assertEqualIs(t, "Pair", pairs, Tuple.Pair("a", []int{1}), Tuple.Pair("a", []int{2}), false)
+
+	triples := Hash.Triple(Hash.Int[int](), Hash.String(), Hash.Float[float64]())
+	assertEqualIs(t, "Triple", triples, Tuple.Triple(1, "a", math.NaN()), Tuple.Triple(1, "a", math.NaN()), true)
+	assertEqualIs(t, "Triple", triples, Tuple.Triple(1, "a", 0.0), Tuple.Triple(1, "a", math.Copysign(0, -1)), true)
+	assertEqualIs(t, "Triple of a different third", triples, Tuple.Triple(1, "a", 1.0), Tuple.Triple(1, "a", 2.0), false)
+	assertEqualIs(t, "Triple of a different first", triples, Tuple.Triple(1, "a", 1.0), Tuple.Triple(2, "a", 1.0), false)
+
+	maybes := Hash.Maybe(Hash.Slice(Hash.Int[int]()))
+	assertEqualIs(t, "Maybe", maybes, MaybeResult.Just([]int{1}), MaybeResult.Just([]int{1}), true)
+	assertEqualIs(t, "Maybe Nothing", maybes, MaybeResult.Nothing[[]int](), MaybeResult.Nothing[[]int](), true)
+	assertEqualIs(t, "Maybe Just and Nothing", maybes, MaybeResult.Just([]int(nil)), MaybeResult.Nothing[[]int](), false)
+
+	results := Hash.Result(Hash.Int[int]())
+	assertEqualIs(t, "Result Ok", results, MaybeResult.Ok(1), MaybeResult.Ok(1), true)
+	assertEqualIs(t, "Result Err", results, MaybeResult.Err[int](errors.New("x")), MaybeResult.Err[int](errors.New("x")), true)
+	assertEqualIs(t, "Result Err", results, MaybeResult.Err[int](errors.New("x")), MaybeResult.Err[int](errors.New("y")), false)
+	assertEqualIs(t, "Result Ok and Err", results, MaybeResult.Ok(0), MaybeResult.Err[int](errors.New("x")), false)
+}
+
+func TestMap(t *testing.T) {
+	maps := Hash.Map(Hash.String(), Hash.Slice(Hash.Int[int]()))
+	assertEqualIs(t, "Map", maps, map[string][]int{"a": {1}, "b": {2}}, map[string][]int{"b": {2}, "a": {1}}, true)
+	assertEqualIs(t, "Map of a different value", maps, map[string][]int{"a": {1}}, map[string][]int{"a": {2}}, false)
+	assertEqualIs(t, "Map of a different key", maps, map[string][]int{"a": {1}}, map[string][]int{"b": {1}}, false)
+	assertEqualIs(t, "Map nil and empty", maps, nil, map[string][]int{}, true)
+
+	// Keys that differ for == but are equal for the key Hasher are still matched up.
+	floats := Hash.Map(Hash.Float[float64](), Hash.String())
+	nan := math.NaN()
+	assertEqualIs(t, "Map with NaN keys", floats, map[float64]string{nan: "x", 1: "y"}, map[float64]string{nan: "x", 1: "y"}, true)
+	assertEqualIs(t, "Map with NaN keys and other values", floats, map[float64]string{nan: "x"}, map[float64]string{nan: "y"}, false)
+	assertEqualIs(t, "Map with signed zero keys", floats, map[float64]string{0: "x"}, map[float64]string{math.Copysign(0, -1): "x"}, true)
+}
+
+func TestMemberUnique(t *testing.T) {
+	list := [][]int{{1}, {2, 3}, {1}, {}, nil}
+	if !Hash.Member(Hash.Slice(Hash.Int[int]()), []int{2, 3}, list) || Hash.Member(Hash.Slice(Hash.Int[int]()), []int{3}, list) {
+		t.Error("Member")
+	}
+	unique := Hash.Unique(Hash.Slice(Hash.Int[int]()), list)
+	if len(unique) != 3 || len(unique[0]) != 1 || len(unique[1]) != 2 || unique[2] == nil {
+		t.Errorf("Unique = %v, want [[1] [2 3] []]", unique)
+	}
+	floats := Hash.Unique(Hash.Float[float64](), []float64{math.NaN(), 0, math.NaN(), math.Copysign(0, -1)})
+	if len(floats) != 2 || !math.IsNaN(floats[0]) || floats[1] != 0 {
+		t.Errorf("Unique of floats = %v, want [NaN 0]", floats)
+	}
+}
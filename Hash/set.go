@@ -0,0 +1,117 @@
+package Hash
+
+import (
+	"hash/maphash"
+)
+
+// A mutable set of values that are compared and hashed with a `Hasher`,
+// so that the values do not have to be `comparable`.
+// Do not change a value while it is in the Set, that would change its hash.
+//
+// Create a Set with `NewSet` or `SetFromList`.
+type Set[T any] struct {
+	hasher  Hasher[T]
+	seed    maphash.Seed
+	buckets map[uint64][]T
+	size    int
+}
+
+// CONSTRUCTION
+
+// Create an empty Set that uses the Hasher.
+func NewSet[T any](hasher Hasher[T]) *Set[T] {
+	return &Set[T]{
+		hasher:  hasher,
+		seed:    maphash.MakeSeed(),
+		buckets: make(map[uint64][]T),
+	}
+}
+
+// Create a Set that uses the Hasher from a list, removing any duplicates.
+func SetFromList[T any](hasher Hasher[T], list []T) *Set[T] {
+	s := NewSet(hasher)
+	for _, v := range list {
+		s.Insert(v)
+	}
+	return s
+}
+
+// METHODS
+
+// Find the position of the value in its bucket, or -1 if it is not there.
+func (s *Set[T]) find(value T) (uint64, int) {
+	key := Sum64(s.hasher, s.seed, value)
+	for i, v := range s.buckets[key] {
+		if s.hasher.Equal(v, value) {
+			return key, i
+		}
+	}
+	return key, -1
+}
+
+// Determine the number of values in the Set.
+func (s *Set[T]) Len() int {
+	return s.size
+}
+
+// Determine if the Set is empty.
+func (s *Set[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Determine if a value is in the Set.
+func (s *Set[T]) Member(value T) bool {
+	_, i := s.find(value)
+	return i >= 0
+}
+
+// Add a value to the Set, returns false if an equal value was already in it.
+func (s *Set[T]) Insert(value T) bool {
+	key, i := s.find(value)
+	if i >= 0 {
+		return false
+	}
+	s.buckets[key] = append(s.buckets[key], value)
+	s.size++
+	return true
+}
+
+// Remove a value from the Set, returns false if it was not in it.
+func (s *Set[T]) Remove(value T) bool {
+	key, i := s.find(value)
+	if i < 0 {
+		return false
+	}
+	bucket := s.buckets[key]
+	if len(bucket) == 1 {
+		delete(s.buckets, key)
+	} else {
+		bucket[i] = bucket[len(bucket)-1]
+		s.buckets[key] = bucket[:len(bucket)-1]
+	}
+	s.size--
+	return true
+}
+
+// Create a copy of the Set, changes to one of them do not affect the other.
+func (s *Set[T]) Clone() *Set[T] {
+	c := &Set[T]{
+		hasher:  s.hasher,
+		seed:    s.seed,
+		buckets: make(map[uint64][]T, len(s.buckets)),
+		size:    s.size,
+	}
+	for key, bucket := range s.buckets {
+		c.buckets[key] = append([]T(nil), bucket...)
+	}
+	return c
+}
+
+// Get all the values in the Set, NOT IN ANY PARTICULAR ORDER.
+func (s *Set[T]) ToList() []T {
+	list := make([]T, 0, s.size)
+	for _, bucket := range s.buckets {
+		list = append(list, bucket...)
+	}
+	return list
+}
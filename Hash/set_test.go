@@ -0,0 +1,57 @@
+package Hash_test
+
+import (
+	"hash/maphash"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Hash"
+)
+
+func TestSet(t *testing.T) {
+	s := Hash.NewSet(Hash.Slice(Hash.String()))
+	if !s.IsEmpty() || s.Member([]string{}) {
+		t.Fatal("a new Set is not empty")
+	}
+	if !s.Insert([]string{"a", "b"}) || s.Insert([]string{"a", "b"}) || !s.Insert(nil) || s.Insert([]string{}) {
+		t.Error("Insert does not report the values already in the Set")
+	}
+	if s.Len() != 2 || !s.Member([]string{"a", "b"}) || !s.Member([]string{}) || s.Member([]string{"b", "a"}) {
+		t.Errorf("the Set has %d values", s.Len())
+	}
+	c := s.Clone()
+	if !s.Remove([]string{"a", "b"}) || s.Remove([]string{"a", "b"}) || s.Len() != 1 {
+		t.Error("Remove does not report the values not in the Set")
+	}
+	if c.Len() != 2 || !c.Member([]string{"a", "b"}) || len(c.ToList()) != 2 {
+		t.Error("Remove changed the Clone")
+	}
+}
+
+func TestSetOfFloats(t *testing.T) {
+	s := Hash.SetFromList(Hash.Float[float64](), []float64{math.NaN(), 1, math.NaN(), 0, math.Copysign(0, -1)})
+	if s.Len() != 3 || !s.Member(math.NaN()) || !s.Member(math.Copysign(0, -1)) {
+		t.Errorf("the Set holds %v", s.ToList())
+	}
+	if !s.Remove(math.NaN()) || s.Member(math.NaN()) {
+		t.Error("NaN could not be removed")
+	}
+}
+
+// Every value hashes the same, so all of them end up in one bucket.
+func TestSetCollisions(t *testing.T) {
+	colliding := Hash.New(func(a, b int) bool { return a == b }, func(h *maphash.Hash, value int) {})
+	s := Hash.NewSet(colliding)
+	for i := 0; i < 10; i++ {
+		s.Insert(i)
+	}
+	s.Remove(3)
+	s.Remove(0)
+	s.Insert(5)
+	values := s.ToList()
+	sort.Ints(values)
+	if s.Len() != 8 || len(values) != 8 || values[0] != 1 || values[2] != 4 || s.Member(3) || !s.Member(9) {
+		t.Errorf("the Set holds %v", values)
+	}
+}
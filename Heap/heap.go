@@ -0,0 +1,220 @@
+package Heap
+
+import (
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
+// A mutable priority queue, implemented as a binary heap.
+// The element that compares the lowest is always popped first,
+// use `NewMax` or a reversed comparison function for a max-heap.
+//
+// Create a Heap with `NewMin`, `NewMax`, `NewWith`, `FromList` or `FromListWith`.
+type Heap[T any] struct {
+	items []*Handle[T]
+	cmpfn func(a, b T) Basics.Order
+}
+
+// Refers to a value pushed onto a Heap, so that it can later be updated or removed.
+type Handle[T any] struct {
+	value T
+	index int
+	heap  *Heap[T]
+}
+
+// Get the value the Handle refers to.
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// Detect wherether the value is still in its Heap, it is not after it was popped or removed.
+func (h *Handle[T]) InHeap() bool {
+	return h.index >= 0
+}
+
+// CONSTRUCTION
+
+// Create an empty Heap that pops the lowest value first.
+func NewMin[T Basics.Ordered]() *Heap[T] {
+	return NewWith(Basics.Compare[T])
+}
+
+// Create an empty Heap that pops the highest value first.
+func NewMax[T Basics.Ordered]() *Heap[T] {
+	return NewWith(Basics.Reversed(Basics.Compare[T]))
+}
+
+// Create an empty Heap that pops the lowest value according to the comparison function first.
+func NewWith[T any](cmpfn func(a, b T) Basics.Order) *Heap[T] {
+	return &Heap[T]{cmpfn: cmpfn}
+}
+
+// Create a Heap that pops the lowest value first from a list, in O(n) time.
+func FromList[T Basics.Ordered](list []T) *Heap[T] {
+	return FromListWith(Basics.Compare[T], list)
+}
+
+// Create a Heap that pops the lowest value according to the comparison function first from a list, in O(n) time.
+func FromListWith[T any](cmpfn func(a, b T) Basics.Order, list []T) *Heap[T] {
+	h := &Heap[T]{
+		items: make([]*Handle[T], len(list)),
+		cmpfn: cmpfn,
+	}
+	for i, value := range list {
+		h.items[i] = &Handle[T]{value: value, index: i, heap: h}
+	}
+	h.heapify()
+	return h
+}
+
+// METHODS
+
+// Determine the number of values in the Heap.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// Determine if the Heap is empty.
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.items) == 0
+}
+
+// Add a value to the Heap in O(log n) time.
+// The returned Handle can be used to update or remove the value later.
+func (h *Heap[T]) Push(value T) *Handle[T] {
+	handle := &Handle[T]{value: value, index: len(h.items), heap: h}
+	h.items = append(h.items, handle)
+	h.up(handle.index)
+	return handle
+}
+
+// Get the lowest value without removing it.
+func (h *Heap[T]) Peek() Maybe[T] {
+	if len(h.items) == 0 {
+		return Nothing[T]()
+	}
+	return Just(h.items[0].value)
+}
+
+// Remove the lowest value and return it, in O(log n) time.
+func (h *Heap[T]) Pop() Maybe[T] {
+	if len(h.items) == 0 {
+		return Nothing[T]()
+	}
+	return Just(h.removeAt(0))
+}
+
+// Change the value the Handle refers to, restoring the heap order in O(log n) time.
+// Works for both decreasing and increasing the value.
+// Returns false if the Handle is not in this Heap anymore.
+func (h *Heap[T]) Update(handle *Handle[T], value T) bool {
+	if handle.heap != h || handle.index < 0 {
+		return false
+	}
+	handle.value = value
+	if !h.up(handle.index) {
+		h.down(handle.index, len(h.items))
+	}
+	return true
+}
+
+// Remove the value the Handle refers to, in O(log n) time.
+// Returns Nothing if the Handle is not in this Heap anymore.
+func (h *Heap[T]) Remove(handle *Handle[T]) Maybe[T] {
+	if handle.heap != h || handle.index < 0 {
+		return Nothing[T]()
+	}
+	return Just(h.removeAt(handle.index))
+}
+
+// Move all the values of the other Heap into this one, in O(n + m) time.
+// The other Heap is left empty, its Handles now refer to values in this Heap.
+func (h *Heap[T]) Merge(other *Heap[T]) {
+	if other == h {
+		return
+	}
+	for _, handle := range other.items {
+		handle.index = len(h.items)
+		handle.heap = h
+		h.items = append(h.items, handle)
+	}
+	other.items = nil
+	h.heapify()
+}
+
+// Get all the values in the Heap, NOT IN ANY PARTICULAR ORDER.
+func (h *Heap[T]) ToList() []T {
+	list := make([]T, len(h.items))
+	for i, handle := range h.items {
+		list[i] = handle.value
+	}
+	return list
+}
+
+// INTERNALS
+
+func (h *Heap[T]) less(i, j int) bool {
+	return h.cmpfn(h.items[i].value, h.items[j].value) < 0
+}
+
+func (h *Heap[T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+// Move the item at index i up until its parent is not greater, returns whether it moved.
+func (h *Heap[T]) up(i int) bool {
+	start := i
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(i, parent) {
+			break
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+	return i != start
+}
+
+// Move the item at index i down until its children are not smaller, within items[:n].
+func (h *Heap[T]) down(i, n int) {
+	for {
+		child := 2*i + 1
+		if child >= n {
+			return
+		}
+		if child+1 < n && h.less(child+1, child) {
+			child++
+		}
+		if !h.less(child, i) {
+			return
+		}
+		h.swap(i, child)
+		i = child
+	}
+}
+
+func (h *Heap[T]) heapify() {
+	n := len(h.items)
+	for i := n/2 - 1; i >= 0; i-- {
+		h.down(i, n)
+	}
+}
+
+func (h *Heap[T]) removeAt(i int) T {
+	last := len(h.items) - 1
+	removed := h.items[i]
+	if i != last {
+		h.swap(i, last)
+	}
+	h.items[last] = nil
+	h.items = h.items[:last]
+	if i != last {
+		if !h.up(i) {
+			h.down(i, last)
+		}
+	}
+	removed.index = -1
+	return removed.value
+}
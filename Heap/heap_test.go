@@ -0,0 +1,163 @@
+package Heap_test
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	"github.com/manwitha1000names/gofp/v3/Heap"
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
+// Pop every value of the Heap, in order.
+func drain[T any](h *Heap.Heap[T]) []T {
+	list := []T{}
+	for value := h.Pop(); value.IsJust(); value = h.Pop() {
+		list = append(list, value.Expect())
+	}
+	return list
+}
+
+func TestPushPop(t *testing.T) {
+	h := Heap.NewMin[int]()
+	if !h.IsEmpty() || h.Pop().IsJust() || h.Peek().IsJust() {
+		t.Fatal("a new Heap is not empty")
+	}
+	for _, value := range []int{5, 1, 4, 1, 3} {
+		h.Push(value)
+	}
+	if h.Len() != 5 || h.Peek() != Just(1) {
+		t.Errorf("Len = %d, Peek = %v", h.Len(), h.Peek())
+	}
+	if got := drain(h); !reflect.DeepEqual(got, []int{1, 1, 3, 4, 5}) {
+		t.Errorf("NewMin pops %v", got)
+	}
+
+	max := Heap.FromList([]int{})
+	if !max.IsEmpty() {
+		t.Error("FromList of an empty list is not empty")
+	}
+	max = Heap.NewMax[int]()
+	for _, value := range []int{5, 1, 4} {
+		max.Push(value)
+	}
+	if got := drain(max); !reflect.DeepEqual(got, []int{5, 4, 1}) {
+		t.Errorf("NewMax pops %v", got)
+	}
+}
+
+func TestFromList(t *testing.T) {
+	list := []int{9, 3, 7, 1, 8, 2, 2}
+	h := Heap.FromList(list)
+	values := h.ToList()
+	sort.Ints(values)
+	if !reflect.DeepEqual(values, []int{1, 2, 2, 3, 7, 8, 9}) {
+		t.Errorf("ToList = %v", values)
+	}
+	if got := drain(h); !reflect.DeepEqual(got, []int{1, 2, 2, 3, 7, 8, 9}) {
+		t.Errorf("FromList pops %v", got)
+	}
+	if !reflect.DeepEqual(list, []int{9, 3, 7, 1, 8, 2, 2}) {
+		t.Errorf("FromList changed the list to %v", list)
+	}
+
+	byLength := Heap.FromListWith(Basics.Comparing(func(s string) int { return len(s) }), []string{"ccc", "a", "bb"})
+	if got := drain(byLength); !reflect.DeepEqual(got, []string{"a", "bb", "ccc"}) {
+		t.Errorf("FromListWith pops %v", got)
+	}
+}
+
+func TestHandles(t *testing.T) {
+	h := Heap.NewMin[int]()
+	five := h.Push(5)
+	three := h.Push(3)
+	eight := h.Push(8)
+
+	if !h.Update(eight, 1) || h.Peek() != Just(1) || eight.Value() != 1 {
+		t.Errorf("decreasing a value did not move it to the top, Peek = %v", h.Peek())
+	}
+	if !h.Update(eight, 9) || h.Peek() != Just(3) {
+		t.Errorf("increasing a value did not move it down, Peek = %v", h.Peek())
+	}
+	if h.Remove(five) != Just(5) || five.InHeap() {
+		t.Error("Remove did not remove the value")
+	}
+	if h.Remove(five).IsJust() || h.Update(five, 0) {
+		t.Error("a removed Handle still changes the Heap")
+	}
+	if h.Pop() != Just(3) || three.InHeap() || !eight.InHeap() {
+		t.Error("Pop did not detach the Handle of the popped value")
+	}
+
+	other := Heap.NewMin[int]()
+	if other.Update(eight, 0) || other.Remove(eight).IsJust() {
+		t.Error("a Handle changed a Heap it does not belong to")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	h := Heap.FromList([]int{4, 2})
+	other := Heap.NewMin[int]()
+	one := other.Push(1)
+	other.Push(3)
+	h.Merge(other)
+	if !other.IsEmpty() || h.Len() != 4 {
+		t.Fatalf("after Merge the Heaps have %d and %d values", h.Len(), other.Len())
+	}
+	// The Handles of the other Heap move along with their values.
+	if !h.Update(one, 5) || other.Update(one, 0) {
+		t.Error("the Handle did not move to the merged Heap")
+	}
+	h.Merge(h)
+	if got := drain(h); !reflect.DeepEqual(got, []int{2, 3, 4, 5}) {
+		t.Errorf("merged Heap pops %v", got)
+	}
+}
+
+// Run random operations on a Heap and on a plain list of the values it should hold.
+func TestRandomOperations(t *testing.T) {
+	random := rand.New(rand.NewSource(6))
+	h := Heap.NewMin[int]()
+	handles := []*Heap.Handle[int]{}
+	smallest := func() int {
+		min := -1
+		for i, handle := range handles {
+			if min < 0 || handle.Value() < handles[min].Value() {
+				min = i
+			}
+		}
+		return min
+	}
+	for step := 0; step < 5000; step++ {
+		switch op := random.Intn(4); {
+		case op == 0 || len(handles) == 0:
+			handles = append(handles, h.Push(random.Intn(100)))
+		case op == 1:
+			want := handles[smallest()].Value()
+			if got := h.Pop(); got != Just(want) {
+				t.Fatalf("step %d: Pop = %v, want %d", step, got, want)
+			}
+			// Equal values may come out in any order, forget whichever Handle was popped.
+			for i, handle := range handles {
+				if !handle.InHeap() {
+					handles = append(handles[:i], handles[i+1:]...)
+					break
+				}
+			}
+		case op == 2:
+			i := random.Intn(len(handles))
+			h.Update(handles[i], random.Intn(100))
+		default:
+			i := random.Intn(len(handles))
+			if got := h.Remove(handles[i]); got != Just(handles[i].Value()) {
+				t.Fatalf("step %d: Remove = %v", step, got)
+			}
+			handles = append(handles[:i], handles[i+1:]...)
+		}
+		if h.Len() != len(handles) {
+			t.Fatalf("step %d: Len = %d, want %d", step, h.Len(), len(handles))
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package Heap
+
+import (
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Tuple"
+)
+
+// An immutable priority queue, implemented as a leftist heap.
+// Every operation returns a new Leftist and leaves the original untouched,
+// the two share most of their structure, so this is cheap.
+// The element that compares the lowest is always popped first.
+//
+// Create a Leftist with `EmptyLeftist`, `EmptyLeftistMax`, `EmptyLeftistWith` or `LeftistFromList`.
+type Leftist[T any] struct {
+	root  *node[T]
+	cmpfn func(a, b T) Basics.Order
+}
+
+type node[T any] struct {
+	value T
+	// The length of the path to the nearest missing child, always shorter on the right.
+	rank  int
+	size  int
+	left  *node[T]
+	right *node[T]
+}
+
+func (n *node[T]) getRank() int {
+	if n == nil {
+		return 0
+	}
+	return n.rank
+}
+
+func (n *node[T]) getSize() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// Build a node, swapping the children if needed to keep the shorter path on the right.
+func makeNode[T any](value T, a, b *node[T]) *node[T] {
+	if a.getRank() < b.getRank() {
+		a, b = b, a
+	}
+	return &node[T]{
+		value: value,
+		rank:  b.getRank() + 1,
+		size:  a.getSize() + b.getSize() + 1,
+		left:  a,
+		right: b,
+	}
+}
+
+// Merge two heaps along their right spines, in O(log n) time.
+func mergeNodes[T any](cmpfn func(a, b T) Basics.Order, a, b *node[T]) *node[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if cmpfn(b.value, a.value) < 0 {
+		a, b = b, a
+	}
+	return makeNode(a.value, a.left, mergeNodes(cmpfn, a.right, b))
+}
+
+// CONSTRUCTION
+
+// Create an empty Leftist that pops the lowest value first.
+func EmptyLeftist[T Basics.Ordered]() Leftist[T] {
+	return EmptyLeftistWith(Basics.Compare[T])
+}
+
+// Create an empty Leftist that pops the highest value first.
+func EmptyLeftistMax[T Basics.Ordered]() Leftist[T] {
+	return EmptyLeftistWith(Basics.Reversed(Basics.Compare[T]))
+}
+
+// Create an empty Leftist that pops the lowest value according to the comparison function first.
+func EmptyLeftistWith[T any](cmpfn func(a, b T) Basics.Order) Leftist[T] {
+	return Leftist[T]{cmpfn: cmpfn}
+}
+
+// Create a Leftist that pops the lowest value first from a list, in O(n) time.
+func LeftistFromList[T Basics.Ordered](list []T) Leftist[T] {
+	return LeftistFromListWith(Basics.Compare[T], list)
+}
+
+// Create a Leftist that pops the lowest value according to the comparison function first from a list, in O(n) time.
+// Singleton heaps are merged pairwise, round after round, until one heap is left.
+func LeftistFromListWith[T any](cmpfn func(a, b T) Basics.Order, list []T) Leftist[T] {
+	if len(list) == 0 {
+		return EmptyLeftistWith(cmpfn)
+	}
+	nodes := make([]*node[T], len(list))
+	for i, value := range list {
+		nodes[i] = makeNode[T](value, nil, nil)
+	}
+	for len(nodes) > 1 {
+		merged := nodes[:0]
+		for i := 0; i < len(nodes); i += 2 {
+			if i+1 < len(nodes) {
+				merged = append(merged, mergeNodes(cmpfn, nodes[i], nodes[i+1]))
+			} else {
+				merged = append(merged, nodes[i])
+			}
+		}
+		nodes = merged
+	}
+	return Leftist[T]{root: nodes[0], cmpfn: cmpfn}
+}
+
+// METHODS
+
+// Determine the number of values in the Leftist.
+func (l Leftist[T]) Len() int {
+	return l.root.getSize()
+}
+
+// Determine if the Leftist is empty.
+func (l Leftist[T]) IsEmpty() bool {
+	return l.root == nil
+}
+
+// Get the lowest value without removing it.
+func (l Leftist[T]) Peek() Maybe[T] {
+	if l.root == nil {
+		return Nothing[T]()
+	}
+	return Just(l.root.value)
+}
+
+// Add a value, in O(log n) time.
+// This functions is IMMUTABLE and produces a new Leftist!
+func (l Leftist[T]) Push(value T) Leftist[T] {
+	return Leftist[T]{root: mergeNodes(l.cmpfn, l.root, makeNode[T](value, nil, nil)), cmpfn: l.cmpfn}
+}
+
+// Split off the lowest value, in O(log n) time.
+// This functions is IMMUTABLE and produces a new Leftist!
+func (l Leftist[T]) Pop() Maybe[Tuple.Tuple[T, Leftist[T]]] {
+	if l.root == nil {
+		return Nothing[Tuple.Tuple[T, Leftist[T]]]()
+	}
+	rest := Leftist[T]{root: mergeNodes(l.cmpfn, l.root.left, l.root.right), cmpfn: l.cmpfn}
+	return Just(Tuple.Pair(l.root.value, rest))
+}
+
+// Combine the values of both heaps, in O(log n + log m) time.
+// The comparison function of this Leftist is used, both should agree on it.
+// This functions is IMMUTABLE and produces a new Leftist!
+func (l Leftist[T]) Merge(other Leftist[T]) Leftist[T] {
+	return Leftist[T]{root: mergeNodes(l.cmpfn, l.root, other.root), cmpfn: l.cmpfn}
+}
+
+// Get all the values in the Leftist, from lowest to highest, in O(n log n) time.
+func (l Leftist[T]) ToList() []T {
+	list := make([]T, 0, l.Len())
+	for root := l.root; root != nil; root = mergeNodes(l.cmpfn, root.left, root.right) {
+		list = append(list, root.value)
+	}
+	return list
+}
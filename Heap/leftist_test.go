@@ -0,0 +1,63 @@
+package Heap_test
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	"github.com/manwitha1000names/gofp/v3/Heap"
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
+func TestLeftist(t *testing.T) {
+	empty := Heap.EmptyLeftist[int]()
+	if !empty.IsEmpty() || empty.Len() != 0 || empty.Peek().IsJust() || empty.Pop().IsJust() {
+		t.Fatal("EmptyLeftist is not empty")
+	}
+	one := empty.Push(3)
+	two := one.Push(1)
+	if empty.Len() != 0 || one.Len() != 1 || two.Len() != 2 {
+		t.Errorf("Push changed an earlier version, lengths %d %d %d", empty.Len(), one.Len(), two.Len())
+	}
+	if two.Peek() != Just(1) {
+		t.Errorf("Peek = %v", two.Peek())
+	}
+	popped := two.Pop().Expect()
+	if popped.Fst != 1 || !reflect.DeepEqual(popped.Snd.ToList(), []int{3}) || two.Len() != 2 {
+		t.Errorf("Pop = %v", popped)
+	}
+
+	max := Heap.EmptyLeftistMax[int]().Push(1).Push(5).Push(3)
+	if got := max.ToList(); !reflect.DeepEqual(got, []int{5, 3, 1}) {
+		t.Errorf("EmptyLeftistMax ToList = %v", got)
+	}
+	byLength := Heap.EmptyLeftistWith(Basics.Comparing(func(s string) int { return len(s) })).Push("ccc").Push("a")
+	if byLength.Peek() != Just("a") {
+		t.Errorf("EmptyLeftistWith Peek = %v", byLength.Peek())
+	}
+}
+
+func TestLeftistFromListAndMerge(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	for _, n := range []int{0, 1, 2, 3, 100} {
+		a, b := make([]int, n), make([]int, n/2)
+		for i := range a {
+			a[i] = random.Intn(50)
+		}
+		for i := range b {
+			b[i] = random.Intn(50)
+		}
+		heapA, heapB := Heap.LeftistFromList(a), Heap.LeftistFromList(b)
+		merged := heapA.Merge(heapB)
+		want := append(append([]int{}, a...), b...)
+		sort.Ints(want)
+		if got := merged.ToList(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Merge of %d and %d values = %v, want %v", n, n/2, got, want)
+		}
+		if heapA.Len() != n || heapB.Len() != n/2 || merged.Len() != n+n/2 {
+			t.Errorf("lengths %d %d %d", heapA.Len(), heapB.Len(), merged.Len())
+		}
+	}
+}
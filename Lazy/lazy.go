@@ -0,0 +1,117 @@
+package Lazy
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Tuple"
+)
+
+// Represents a computation that is deferred until its value is needed.
+// The computation runs at most once, and its value is memoized.
+// A Lazy can be copied and shared across goroutines freely,
+// all the copies share the same computation and value.
+//
+// The zero value is not usable, create a Lazy with `New`, `Value` or `Try`.
+type Lazy[T any] struct {
+	cell *cell[T]
+}
+
+type cell[T any] struct {
+	once   sync.Once
+	forced atomic.Bool
+	fn     func() T
+	value  T
+	panic  *panicked
+}
+
+// The recovered value of a computation that panicked.
+type panicked struct {
+	value any
+}
+
+// CONSTRUCTION
+
+// Create a Lazy value that is computed by the function the first time it is forced.
+func New[T any](fn func() T) Lazy[T] {
+	return Lazy[T]{&cell[T]{fn: fn}}
+}
+
+// Create a Lazy value that has already been computed.
+func Value[T any](value T) Lazy[T] {
+	c := &cell[T]{value: value}
+	c.once.Do(func() {})
+	c.forced.Store(true)
+	return Lazy[T]{c}
+}
+
+// Create a Lazy value from a fallible initialization.
+// The function runs at most once, and its outcome, be it a value or an error, is memoized.
+func Try[T any](fn func() (T, error)) Lazy[MaybeResult.Result[T]] {
+	return New(func() MaybeResult.Result[T] {
+		return MaybeResult.ErrToResult(fn())
+	})
+}
+
+// METHODS
+
+// Compute the value if that has not happened yet, and return it.
+// Concurrent calls block until the single computation has finished.
+//
+// If the computation panics, the panic is memoized like a value would be:
+// this and every later call to `Force` panic with the same value, the computation is not retried.
+func (l Lazy[T]) Force() T {
+	c := l.cell
+	c.once.Do(func() {
+		defer func() {
+			if rec := recover(); rec != nil {
+				c.panic = &panicked{rec}
+			}
+			c.fn = nil
+			c.forced.Store(true)
+		}()
+		c.value = c.fn()
+	})
+	if c.panic != nil {
+		panic(c.panic.value)
+	}
+	return c.value
+}
+
+// Detect wherether the value has already been computed.
+// A computation that panicked counts as computed.
+func (l Lazy[T]) IsForced() bool {
+	return l.cell.forced.Load()
+}
+
+// TRANSFORM
+
+// Transform the deferred value, the function runs when the result is forced.
+func Map[T, U any](mapfn func(value T) U, l Lazy[T]) Lazy[U] {
+	return New(func() U {
+		return mapfn(l.Force())
+	})
+}
+
+// Chain together deferred computations, nothing runs until the result is forced.
+func AndThen[T, U any](fn func(value T) Lazy[U], l Lazy[T]) Lazy[U] {
+	return New(func() U {
+		return fn(l.Force()).Force()
+	})
+}
+
+// Combine two deferred values into a tuple, both are forced when the result is forced.
+func Zip[T, U any](a Lazy[T], b Lazy[U]) Lazy[Tuple.Tuple[T, U]] {
+	return New(func() Tuple.Tuple[T, U] {
+		return Tuple.Pair(a.Force(), b.Force())
+	})
+}
+
+// Transform the deferred value of a fallible computation, the function only runs on an `Ok` value.
+// An `Err` is passed on as is, keeping its captured stack.
+func MapResult[T, U any](mapfn func(value T) U, l Lazy[MaybeResult.Result[T]]) Lazy[MaybeResult.Result[U]] {
+	return New(func() MaybeResult.Result[U] {
+		return MaybeResult.MapResult(mapfn, l.Force())
+	})
+}
@@ -0,0 +1,179 @@
+package Lazy_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Lazy"
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Tuple"
+)
+
+// Create a Lazy value that counts how many times it was computed.
+func counted(value int) (Lazy.Lazy[int], *atomic.Int32) {
+	calls := &atomic.Int32{}
+	return Lazy.New(func() int {
+		calls.Add(1)
+		return value
+	}), calls
+}
+
+func TestNewIsDeferredAndMemoized(t *testing.T) {
+	l, calls := counted(7)
+	if calls.Load() != 0 || l.IsForced() {
+		t.Fatal("the computation ran before Force")
+	}
+	for i := 0; i < 3; i++ {
+		if got := l.Force(); got != 7 {
+			t.Errorf("Force = %d, want 7", got)
+		}
+	}
+	if calls.Load() != 1 || !l.IsForced() {
+		t.Errorf("the computation ran %d times, want once", calls.Load())
+	}
+}
+
+func TestCopiesShareTheComputation(t *testing.T) {
+	l, calls := counted(1)
+	copied := l
+	l.Force()
+	copied.Force()
+	if calls.Load() != 1 || !copied.IsForced() {
+		t.Errorf("the computation ran %d times for two copies, want once", calls.Load())
+	}
+}
+
+func TestConcurrentForceComputesOnce(t *testing.T) {
+	l, calls := counted(3)
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := l.Force(); got != 3 {
+				t.Errorf("Force = %d, want 3", got)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Errorf("the computation ran %d times, want once", calls.Load())
+	}
+}
+
+func TestValue(t *testing.T) {
+	l := Lazy.Value("ready")
+	if !l.IsForced() || l.Force() != "ready" {
+		t.Errorf("Value is not forced or has the wrong value")
+	}
+}
+
+func TestTryMemoizesTheError(t *testing.T) {
+	calls := 0
+	l := Lazy.Try(func() (int, error) {
+		calls++
+		return 0, io.EOF
+	})
+	for i := 0; i < 2; i++ {
+		if r := l.Force(); r.Unwrap() != io.EOF {
+			t.Errorf("Force = %v, want Err(EOF)", r)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("the initialization ran %d times, want once", calls)
+	}
+}
+
+func forcePanic(l Lazy.Lazy[int]) (rec any) {
+	defer func() { rec = recover() }()
+	l.Force()
+	return nil
+}
+
+func TestPanicIsMemoized(t *testing.T) {
+	calls := 0
+	l := Lazy.New(func() int {
+		calls++
+		panic("boom")
+	})
+	for i := 0; i < 2; i++ {
+		if rec := forcePanic(l); rec != "boom" {
+			t.Errorf("Force %d recovered %v, want the panic of the computation", i+1, rec)
+		}
+	}
+	if calls != 1 || !l.IsForced() {
+		t.Errorf("the computation ran %d times, want once", calls)
+	}
+	if rec := forcePanic(Lazy.Map(func(n int) int { return n }, l)); rec != "boom" {
+		t.Errorf("a Lazy built on a panicking one recovered %v", rec)
+	}
+}
+
+func TestTransformsAreDeferred(t *testing.T) {
+	a, callsA := counted(2)
+	b, callsB := counted(3)
+	mapped := Lazy.Map(func(n int) int { return n * 10 }, a)
+	chained := Lazy.AndThen(func(n int) Lazy.Lazy[int] { return Lazy.Value(n + 1) }, a)
+	zipped := Lazy.Zip(a, b)
+	if callsA.Load() != 0 || callsB.Load() != 0 {
+		t.Fatal("building transforms forced their inputs")
+	}
+	if got := mapped.Force(); got != 20 {
+		t.Errorf("Map = %d, want 20", got)
+	}
+	if got := chained.Force(); got != 3 {
+		t.Errorf("AndThen = %d, want 3", got)
+	}
+	if got := zipped.Force(); got != Tuple.Pair(2, 3) {
+		t.Errorf("Zip = %v, want (2, 3)", got)
+	}
+	if callsA.Load() != 1 || callsB.Load() != 1 {
+		t.Errorf("inputs ran %d and %d times, want once each", callsA.Load(), callsB.Load())
+	}
+}
+
+func failingLoad() (int, error) {
+	return 0, io.EOF
+}
+
+func TestMapResult(t *testing.T) {
+	ok := Lazy.MapResult(func(n int) string { return strings.Repeat("x", n) }, Lazy.Value(MaybeResult.Ok(2)))
+	if got := ok.Force(); got.Expect() != "xx" {
+		t.Errorf("MapResult(Ok) = %v", got)
+	}
+
+	MaybeResult.CaptureStackTraces(true)
+	defer MaybeResult.CaptureStackTraces(false)
+	source := Lazy.Try(failingLoad)
+	mapped := Lazy.MapResult(func(n int) string {
+		t.Error("the function ran on an Err")
+		return ""
+	}, source).Force()
+	if !errors.Is(mapped, io.EOF) || mapped.Unwrap() != io.EOF {
+		t.Errorf("MapResult(Err) = %v, want Err(EOF)", mapped)
+	}
+	want, got := MaybeResult.StackTrace(source.Force()), MaybeResult.StackTrace(mapped)
+	if len(got) == 0 || len(got) != len(want) || got[0] != want[0] {
+		t.Error("MapResult did not keep the stack of the Err")
+	}
+}
+
+func TestOrLazy(t *testing.T) {
+	fallback, calls := counted(9)
+	if got := MaybeResult.Just(1).OrLazy(fallback); got != 1 || calls.Load() != 0 {
+		t.Errorf("Just(1).OrLazy = %d and forced the fallback %d times", got, calls.Load())
+	}
+	if got := MaybeResult.Nothing[int]().OrLazy(fallback); got != 9 {
+		t.Errorf("Nothing.OrLazy = %d, want 9", got)
+	}
+	if got := MaybeResult.Err[int](io.EOF).OrLazy(fallback); got != 9 || calls.Load() != 1 {
+		t.Errorf("Err.OrLazy = %d and computed the fallback %d times", got, calls.Load())
+	}
+	if got := MaybeResult.Ok(2).OrLazy(fallback); got != 2 {
+		t.Errorf("Ok(2).OrLazy = %d", got)
+	}
+}
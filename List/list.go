@@ -1,9 +1,12 @@
 package List
 
 import (
+	"fmt"
+	"math"
 	"math/bits"
 
 	"github.com/manwitha1000names/gofp/v3/Basics"
+	"github.com/manwitha1000names/gofp/v3/Either"
 	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
 	"github.com/manwitha1000names/gofp/v3/Tuple"
 )
@@ -14,6 +17,24 @@ func clone[T any](value []T) []T {
 	return new_list
 }
 
+// Merge the sorted lists left and right into dst, which must fit both of them.
+// Takes from left on ties, so the merge is stable.
+func mergeInto[E any](dst, left, right []E, lessfn func(a, b E) bool) {
+	i, j, k := 0, 0, 0
+	for i < len(left) && j < len(right) {
+		if lessfn(right[j], left[i]) {
+			dst[k] = right[j]
+			j++
+		} else {
+			dst[k] = left[i]
+			i++
+		}
+		k++
+	}
+	k += copy(dst[k:], left[i:])
+	copy(dst[k:], right[j:])
+}
+
 // CREATE
 
 // Create a list with only one element
@@ -51,6 +72,44 @@ func Cons[T any](value T, list []T) []T {
 	return append(append(new_list, value), list...)
 }
 
+// Create a list by applying the function to the value, then to the result, and so on, until it returns Nothing.
+// The starting value is the first element.
+// This functions is IMMUTABLE and produces a completely new list!
+func Iterate[T any](fn func(value T) Maybe[T], value T) []T {
+	new_list := []T{value}
+	for next := fn(value); next.IsJust(); next = fn(value) {
+		value = next.Expect()
+		new_list = append(new_list, value)
+	}
+	return new_list
+}
+
+// Build a list from a seed value. The function produces an element and the next seed, until it returns Nothing.
+// This functions is IMMUTABLE and produces a completely new list!
+func Unfoldr[S, T any](fn func(seed S) Maybe[Tuple.Tuple[T, S]], seed S) []T {
+	new_list := make([]T, 0, 8)
+	for next := fn(seed); next.IsJust(); next = fn(seed) {
+		pair := next.Expect()
+		new_list = append(new_list, pair.Fst)
+		seed = pair.Snd
+	}
+	return new_list
+}
+
+// Create a list of the given length by repeating the elements of a list over and over.
+// An empty list results in an empty list.
+// This functions is IMMUTABLE and produces a completely new list!
+func Cycle[T any](length int, list []T) []T {
+	if len(list) == 0 || length <= 0 {
+		return []T{}
+	}
+	new_list := make([]T, length)
+	for i := 0; i < length; i += len(list) {
+		copy(new_list[i:], list)
+	}
+	return new_list
+}
+
 // TRANSFORM
 
 // Apply a function to every element of a list.
@@ -89,6 +148,33 @@ func Foldr[T, U any](reducefn func(value T, accumulator U) U, acc U, list []T) U
 	return acc
 }
 
+// Reduce a list from the left, keeping every intermediate accumulator.
+// The result starts with the initial accumulator and has one more element than the list.
+// This functions is IMMUTABLE and produces a completely new list!
+func Scanl[T, U any](reducefn func(value T, accumulator U) U, acc U, list []T) []U {
+	new_list := make([]U, len(list)+1)
+	new_list[0] = acc
+	for i, v := range list {
+		acc = reducefn(v, acc)
+		new_list[i+1] = acc
+	}
+	return new_list
+}
+
+// Reduce a list from the right, keeping every intermediate accumulator.
+// The result ends with the initial accumulator and has one more element than the list,
+// the element at index i is the reduction of the elements from index i onward.
+// This functions is IMMUTABLE and produces a completely new list!
+func Scanr[T, U any](reducefn func(value T, accumulator U) U, acc U, list []T) []U {
+	new_list := make([]U, len(list)+1)
+	new_list[len(list)] = acc
+	for i := len(list) - 1; i >= 0; i-- {
+		acc = reducefn(list[i], acc)
+		new_list[i] = acc
+	}
+	return new_list
+}
+
 // Keep elements that satisfy the test.
 // This functions is IMMUTABLE and produces a completely new list!
 func Filter[T any](testfn func(value T) bool, list []T) []T {
@@ -157,6 +243,70 @@ func Any[T any](test func(value T) bool, list []T) bool {
 	return false
 }
 
+// Remove duplicate elements, keeping the first occurrence of each.
+// This functions is IMMUTABLE and produces a completely new list!
+func Unique[T comparable](list []T) []T {
+	return UniqueBy(func(value T) T { return value }, list)
+}
+
+// Remove elements with a duplicate derived property, keeping the first occurrence of each.
+// This functions is IMMUTABLE and produces a completely new list!
+func UniqueBy[T any, U comparable](mapfn func(value T) U, list []T) []T {
+	seen := make(map[U]struct{}, len(list))
+	new_list := make([]T, 0, len(list))
+	for _, v := range list {
+		key := mapfn(v)
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			new_list = append(new_list, v)
+		}
+	}
+	return new_list
+}
+
+// Remove the first occurrence of a value from a list.
+// This functions is IMMUTABLE and produces a completely new list!
+func Remove[T comparable](value T, list []T) []T {
+	return RemoveAt(ElemIndex(value, list).WithDefault(-1), list)
+}
+
+// Remove the element at the index.
+// If the index is out of range, the list is unaltered.
+// This functions is IMMUTABLE and produces a completely new list!
+func RemoveAt[T any](index int, list []T) []T {
+	if index < 0 || index >= len(list) {
+		return clone(list)
+	}
+	new_list := make([]T, 0, len(list)-1)
+	return append(append(new_list, list[:index]...), list[index+1:]...)
+}
+
+// Insert an element at the index, moving the elements from that index onward one place back.
+// An index equal to the length of the list appends the element.
+// If the index is out of range, the list is unaltered.
+// This functions is IMMUTABLE and produces a completely new list!
+func InsertAt[T any](index int, value T, list []T) []T {
+	if index < 0 || index > len(list) {
+		return clone(list)
+	}
+	new_list := make([]T, 0, len(list)+1)
+	new_list = append(append(new_list, list[:index]...), value)
+	return append(new_list, list[index:]...)
+}
+
+// Apply a function to every element that satisfies the test, leaving the other elements as they are.
+// This functions is IMMUTABLE and produces a completely new list!
+func Update[T any](testfn func(value T) bool, mapfn func(value T) T, list []T) []T {
+	return Update_mut(testfn, mapfn, clone(list))
+}
+
+// Apply a function to the element at the index.
+// If the index is out of range, the list is unaltered.
+// This functions is IMMUTABLE and produces a completely new list!
+func UpdateAt[T any](index int, mapfn func(value T) T, list []T) []T {
+	return UpdateAt_mut(index, mapfn, clone(list))
+}
+
 // Find the maximum element in a non-empty list
 func Maximum[T Basics.Ordered](list []T) Maybe[T] {
 	if len(list) == 0 {
@@ -173,34 +323,180 @@ func Minimum[T Basics.Ordered](list []T) Maybe[T] {
 	return Just(Basics.Min(list[0], list[1:]...))
 }
 
+// Find the index of the maximum element in a non-empty list.
+// If the maximum appears many times, the first index is returned.
 func MaximumIndex[T Basics.Ordered](list []T) Maybe[int] {
+	return MaximumIndexWith(Basics.Compare[T], list)
+}
+
+// Find the index of the minimum element in a non-empty list.
+// If the minimum appears many times, the first index is returned.
+func MinimumIndex[T Basics.Ordered](list []T) Maybe[int] {
+	return MinimumIndexWith(Basics.Compare[T], list)
+}
+
+// Find the index of the first element that compares the lowest, after flipping the comparison when sign is -1.
+func extremeIndexWith[T any](sign Basics.Order, cmpfn func(a, b T) Basics.Order, list []T) Maybe[int] {
 	if len(list) == 0 {
 		return Nothing[int]()
 	}
-	max_index := 0
-	max_val := list[max_index]
-	for i, val := range list[1:] {
-		if val > max_val {
-			max_val = val
-			max_index = i
+	best := 0
+	for i := 1; i < len(list); i++ {
+		if sign*cmpfn(list[i], list[best]) < 0 {
+			best = i
 		}
 	}
-	return Just(max_index)
+	return Just(best)
 }
 
-func MinimumIndex[T Basics.Ordered](list []T) Maybe[int] {
+// Find the indexes of all the elements that compare the lowest, after flipping the comparison when sign is -1.
+func extremeIndicesWith[T any](sign Basics.Order, cmpfn func(a, b T) Basics.Order, list []T) []int {
+	indices := make([]int, 0, 1)
+	for i := range list {
+		if len(indices) == 0 {
+			indices = append(indices, i)
+			continue
+		}
+		order := sign * cmpfn(list[i], list[indices[0]])
+		if order < 0 {
+			indices = append(indices[:0], i)
+		} else if order == 0 {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// Get the element at the index, if there is an index.
+func elementAt[T any](index Maybe[int], list []T) Maybe[T] {
+	return Match(index, func(i int) Maybe[T] { return Just(list[i]) }, Nothing[T])
+}
+
+// Find the element with the largest derived property in a non-empty list.
+// The function is applied once per element. If several elements tie, the first one is returned.
+func MaximumBy[T any, U Basics.Ordered](mapfn func(value T) U, list []T) Maybe[T] {
+	return elementAt(MaximumIndexBy(mapfn, list), list)
+}
+
+// Find the element with the smallest derived property in a non-empty list.
+// The function is applied once per element. If several elements tie, the first one is returned.
+func MinimumBy[T any, U Basics.Ordered](mapfn func(value T) U, list []T) Maybe[T] {
+	return elementAt(MinimumIndexBy(mapfn, list), list)
+}
+
+// Find the largest element according to the comparison function in a non-empty list.
+// If several elements tie, the first one is returned.
+func MaximumWith[T any](cmpfn func(a, b T) Basics.Order, list []T) Maybe[T] {
+	return elementAt(MaximumIndexWith(cmpfn, list), list)
+}
+
+// Find the smallest element according to the comparison function in a non-empty list.
+// If several elements tie, the first one is returned.
+func MinimumWith[T any](cmpfn func(a, b T) Basics.Order, list []T) Maybe[T] {
+	return elementAt(MinimumIndexWith(cmpfn, list), list)
+}
+
+// Find the index of the element with the largest derived property in a non-empty list.
+// The function is applied once per element. If several elements tie, the first index is returned.
+func MaximumIndexBy[T any, U Basics.Ordered](mapfn func(value T) U, list []T) Maybe[int] {
+	return MaximumIndex(Map(mapfn, list))
+}
+
+// Find the index of the element with the smallest derived property in a non-empty list.
+// The function is applied once per element. If several elements tie, the first index is returned.
+func MinimumIndexBy[T any, U Basics.Ordered](mapfn func(value T) U, list []T) Maybe[int] {
+	return MinimumIndex(Map(mapfn, list))
+}
+
+// Find the index of the largest element according to the comparison function in a non-empty list.
+// If several elements tie, the first index is returned.
+func MaximumIndexWith[T any](cmpfn func(a, b T) Basics.Order, list []T) Maybe[int] {
+	return extremeIndexWith(-1, cmpfn, list)
+}
+
+// Find the index of the smallest element according to the comparison function in a non-empty list.
+// If several elements tie, the first index is returned.
+func MinimumIndexWith[T any](cmpfn func(a, b T) Basics.Order, list []T) Maybe[int] {
+	return extremeIndexWith(1, cmpfn, list)
+}
+
+// Find the indexes of every occurrence of the maximum, in order.
+// An empty list results in an empty list.
+func MaximumIndices[T Basics.Ordered](list []T) []int {
+	return MaximumIndicesWith(Basics.Compare[T], list)
+}
+
+// Find the indexes of every occurrence of the minimum, in order.
+// An empty list results in an empty list.
+func MinimumIndices[T Basics.Ordered](list []T) []int {
+	return MinimumIndicesWith(Basics.Compare[T], list)
+}
+
+// Find the indexes of all the elements with the largest derived property, in order.
+// The function is applied once per element.
+func MaximumIndicesBy[T any, U Basics.Ordered](mapfn func(value T) U, list []T) []int {
+	return MaximumIndices(Map(mapfn, list))
+}
+
+// Find the indexes of all the elements with the smallest derived property, in order.
+// The function is applied once per element.
+func MinimumIndicesBy[T any, U Basics.Ordered](mapfn func(value T) U, list []T) []int {
+	return MinimumIndices(Map(mapfn, list))
+}
+
+// Find the indexes of all the largest elements according to the comparison function, in order.
+func MaximumIndicesWith[T any](cmpfn func(a, b T) Basics.Order, list []T) []int {
+	return extremeIndicesWith(-1, cmpfn, list)
+}
+
+// Find the indexes of all the smallest elements according to the comparison function, in order.
+func MinimumIndicesWith[T any](cmpfn func(a, b T) Basics.Order, list []T) []int {
+	return extremeIndicesWith(1, cmpfn, list)
+}
+
+// Find both the minimum and the maximum element of a non-empty list, in a single pass.
+// The minimum is the first value of the tuple, the maximum the second.
+func MinMax[T Basics.Ordered](list []T) Maybe[Tuple.Tuple[T, T]] {
+	return MinMaxWith(Basics.Compare[T], list)
+}
+
+// Find both the elements with the smallest and the largest derived property of a non-empty list, in a single pass.
+// The function is applied once per element. If several elements tie, the first ones are returned.
+func MinMaxBy[T any, U Basics.Ordered](mapfn func(value T) U, list []T) Maybe[Tuple.Tuple[T, T]] {
 	if len(list) == 0 {
-		return Nothing[int]()
+		return Nothing[Tuple.Tuple[T, T]]()
+	}
+	low, high := 0, 0
+	low_key := mapfn(list[0])
+	high_key := low_key
+	for i := 1; i < len(list); i++ {
+		key := mapfn(list[i])
+		if key < low_key {
+			low, low_key = i, key
+		}
+		if key > high_key {
+			high, high_key = i, key
+		}
 	}
-	min_index := 0
-	min_val := list[min_index]
-	for i, val := range list {
-		if val < min_val {
-			min_val = val
-			min_index = i
+	return Just(Tuple.Pair(list[low], list[high]))
+}
+
+// Find both the smallest and the largest element according to the comparison function of a non-empty list, in a single pass.
+// If several elements tie, the first ones are returned.
+func MinMaxWith[T any](cmpfn func(a, b T) Basics.Order, list []T) Maybe[Tuple.Tuple[T, T]] {
+	if len(list) == 0 {
+		return Nothing[Tuple.Tuple[T, T]]()
+	}
+	low, high := list[0], list[0]
+	for _, v := range list[1:] {
+		if cmpfn(v, low) < 0 {
+			low = v
+		}
+		if cmpfn(v, high) > 0 {
+			high = v
 		}
 	}
-	return Just(min_index)
+	return Just(Tuple.Pair(low, high))
 }
 
 // Get the sum of the list elements.
@@ -216,7 +512,15 @@ func Product[T Basics.Number](list []T) Maybe[T] {
 	if len(list) == 0 {
 		return Nothing[T]()
 	}
-	return Just(Foldl(Basics.Mul[T], T(0), list))
+	return Just(Foldl(Basics.Mul[T], T(1), list))
+}
+
+// Map every element to a value of a Monoid and combine all of them, from left to right.
+// An empty list results in the identity value of the Monoid.
+func MapReduce[T, U any](mapfn func(value T) U, monoid Basics.Monoid[U], list []T) U {
+	return Foldl(func(value T, acc U) U {
+		return monoid.Combine(acc, mapfn(value))
+	}, monoid.Empty(), list)
 }
 
 // COMBINE
@@ -300,6 +604,364 @@ func Map5[a, b, c, d, e, result any](mapfn func(a a, b b, c c, d d, e e) result,
 	return new_list
 }
 
+// Combine two lists into a list of pairs. If one list is longer, the extra elements are dropped.
+// This functions is IMMUTABLE and produces a completely new list!
+func Zip[T, U any](listA []T, listB []U) []Tuple.Tuple[T, U] {
+	return Map2(Tuple.Pair[T, U], listA, listB)
+}
+
+// Combine three lists into a list of triples. If one list is longer, the extra elements are dropped.
+// This functions is IMMUTABLE and produces a completely new list!
+func Zip3[T, U, V any](listA []T, listB []U, listC []V) []Tuple.Tuple3[T, U, V] {
+	return Map3(Tuple.Triple[T, U, V], listA, listB, listC)
+}
+
+// Combine four lists into a list of 4-tuples. If one list is longer, the extra elements are dropped.
+// This functions is IMMUTABLE and produces a completely new list!
+func Zip4[T, U, V, W any](listA []T, listB []U, listC []V, listD []W) []Tuple.Tuple4[T, U, V, W] {
+	return Map4(Tuple.Quadruple[T, U, V, W], listA, listB, listC, listD)
+}
+
+// Combine five lists into a list of 5-tuples. If one list is longer, the extra elements are dropped.
+// This functions is IMMUTABLE and produces a completely new list!
+func Zip5[T, U, V, W, X any](listA []T, listB []U, listC []V, listD []W, listE []X) []Tuple.Tuple5[T, U, V, W, X] {
+	return Map5(Tuple.Quintuple[T, U, V, W, X], listA, listB, listC, listD, listE)
+}
+
+// Turn the rows of a list of lists into columns.
+// If the rows differ in length, the extra elements of the longer rows are dropped.
+// This functions is IMMUTABLE and produces a completely new list!
+func Transpose[T any](lists [][]T) [][]T {
+	if len(lists) == 0 {
+		return [][]T{}
+	}
+	width := len(lists[0])
+	for _, row := range lists[1:] {
+		width = Basics.Min(width, len(row))
+	}
+	columns := make([][]T, width)
+	for i := range columns {
+		columns[i] = make([]T, len(lists))
+		for j, row := range lists {
+			columns[i][j] = row[i]
+		}
+	}
+	return columns
+}
+
+// GROUP
+
+// Clone every group, so that it does not share memory with the original list.
+func cloneAll[T any](groups [][]T) [][]T {
+	for i, group := range groups {
+		groups[i] = clone(group)
+	}
+	return groups
+}
+
+// Split a list into chunks of the given size.
+// The last chunk holds the remaining elements, and may be smaller.
+// This functions is IMMUTABLE and produces a completely new list!
+func Chunk[T any](size int, list []T) [][]T {
+	return cloneAll(Chunk_view(size, list))
+}
+
+// Get the sliding windows of the given size, starting a new window every `step` elements.
+// Windows that would run past the end of the list are not included.
+// This functions is IMMUTABLE and produces a completely new list!
+func Windows[T any](size int, step int, list []T) [][]T {
+	return cloneAll(Windows_view(size, step, list))
+}
+
+// Group consecutive elements, as long as every pair of neighbours satisfies the test.
+// The test is given the previous element and the next element.
+// This functions is IMMUTABLE and produces a completely new list!
+func GroupWhile[T any](testfn func(previous T, next T) bool, list []T) [][]T {
+	return cloneAll(GroupWhile_view(testfn, list))
+}
+
+// Group consecutive elements that have an equal derived property.
+// Equal keys that are not next to each other end up in different groups, sort the list first to avoid that.
+// This functions is IMMUTABLE and produces a completely new list!
+func GroupBy[T any, U comparable](mapfn func(value T) U, list []T) [][]T {
+	return cloneAll(GroupBy_view(mapfn, list))
+}
+
+// Split a list before every element that satisfies the test, except the first element.
+// No elements are dropped, every element that satisfies the test starts a new group.
+// This functions is IMMUTABLE and produces a completely new list!
+func SplitWhen[T any](testfn func(value T) bool, list []T) [][]T {
+	return cloneAll(SplitWhen_view(testfn, list))
+}
+
+// Pair every element with the element after it.
+// A list with less than two elements results in an empty list.
+// This functions is IMMUTABLE and produces a completely new list!
+func Pairwise[T any](list []T) []Tuple.Tuple[T, T] {
+	return Zip(list, Drop(Basics.Min(1, len(list)), list))
+}
+
+// COMBINATORICS
+
+// Drain an iterator into a list.
+func collect[T any](next func() Maybe[T]) []T {
+	new_list := make([]T, 0, 8)
+	for value := next(); value.IsJust(); value = next() {
+		new_list = append(new_list, value.Expect())
+	}
+	return new_list
+}
+
+// Pick the elements of the list at the given indexes.
+func pick[T any](indexes []int, list []T) []T {
+	new_list := make([]T, len(indexes))
+	for i, index := range indexes {
+		new_list[i] = list[index]
+	}
+	return new_list
+}
+
+// Get all the orderings of a list, in lexicographic order of the original positions,
+// so the first permutation is the list itself. A list of n elements has n! permutations.
+// Takes O(n * n!) time and space, use `PermutationsIterator` to avoid holding all of them.
+// This functions is IMMUTABLE and produces a completely new list!
+func Permutations[T any](list []T) [][]T {
+	return collect(PermutationsIterator(list))
+}
+
+// Same as `Permutations`, but every permutation is produced on demand.
+// The iterator returns Nothing once all permutations have been produced.
+// Every call takes O(n) time.
+func PermutationsIterator[T any](list []T) func() Maybe[[]T] {
+	indexes := Range(0, len(list)-1)
+	done := false
+	return func() Maybe[[]T] {
+		if done {
+			return Nothing[[]T]()
+		}
+		permutation := pick(indexes, list)
+		// Step to the next permutation: find the last ascent, swap it with the smallest larger index after it
+		// and reverse the tail.
+		i := len(indexes) - 2
+		for i >= 0 && indexes[i] > indexes[i+1] {
+			i--
+		}
+		if i < 0 {
+			done = true
+		} else {
+			j := len(indexes) - 1
+			for indexes[j] < indexes[i] {
+				j--
+			}
+			indexes[i], indexes[j] = indexes[j], indexes[i]
+			Reverse_mut(indexes[i+1:])
+		}
+		return Just(permutation)
+	}
+}
+
+// Get all the ways to choose k elements from a list, keeping them in their original order.
+// The combinations are in lexicographic order of the original positions.
+// A list of n elements has n! / (k! * (n - k)!) combinations, none if k is negative or larger than n.
+// Takes O(k) time and space per combination, use `CombinationsIterator` to avoid holding all of them.
+// This functions is IMMUTABLE and produces a completely new list!
+func Combinations[T any](k int, list []T) [][]T {
+	return collect(CombinationsIterator(k, list))
+}
+
+// Same as `Combinations`, but every combination is produced on demand.
+// The iterator returns Nothing once all combinations have been produced.
+func CombinationsIterator[T any](k int, list []T) func() Maybe[[]T] {
+	n := len(list)
+	indexes := Range(0, k-1)
+	done := k < 0 || k > n
+	return func() Maybe[[]T] {
+		if done {
+			return Nothing[[]T]()
+		}
+		combination := pick(indexes, list)
+		// Step to the next combination: increment the last index that has room to grow,
+		// and put the indexes after it right behind it.
+		i := k - 1
+		for i >= 0 && indexes[i] == i+n-k {
+			i--
+		}
+		if i < 0 {
+			done = true
+		} else {
+			indexes[i]++
+			for j := i + 1; j < k; j++ {
+				indexes[j] = indexes[j-1] + 1
+			}
+		}
+		return Just(combination)
+	}
+}
+
+// Get all the subsequences of a list, from the empty list up to the list itself.
+// Elements keep their original order. The subsequences are ordered like counting in binary,
+// with the first element as the lowest bit: [], [a], [b], [a b], [c], [a c], ...
+// A list of n elements has 2^n subsequences.
+// Takes O(n * 2^n) time and space, use `SubsequencesIterator` to avoid holding all of them.
+// This functions is IMMUTABLE and produces a completely new list!
+func Subsequences[T any](list []T) [][]T {
+	return collect(SubsequencesIterator(list))
+}
+
+// Same as `Subsequences`, but every subsequence is produced on demand.
+// The iterator returns Nothing once all subsequences have been produced.
+func SubsequencesIterator[T any](list []T) func() Maybe[[]T] {
+	chosen := make([]bool, len(list))
+	done := false
+	return func() Maybe[[]T] {
+		if done {
+			return Nothing[[]T]()
+		}
+		subsequence := make([]T, 0, len(list))
+		for i, ok := range chosen {
+			if ok {
+				subsequence = append(subsequence, list[i])
+			}
+		}
+		// Add one to the binary counter, done once it overflows.
+		i := 0
+		for i < len(chosen) && chosen[i] {
+			chosen[i] = false
+			i++
+		}
+		if i == len(chosen) {
+			done = true
+		} else {
+			chosen[i] = true
+		}
+		return Just(subsequence)
+	}
+}
+
+// Pair every element of the first list with every element of the second list.
+// The pairs are in lexicographic order, the second element varies fastest.
+// Takes O(n * m) time and space.
+// This functions is IMMUTABLE and produces a completely new list!
+func CartesianProduct[T, U any](listA []T, listB []U) []Tuple.Tuple[T, U] {
+	new_list := make([]Tuple.Tuple[T, U], 0, len(listA)*len(listB))
+	for _, a := range listA {
+		for _, b := range listB {
+			new_list = append(new_list, Tuple.Pair(a, b))
+		}
+	}
+	return new_list
+}
+
+// Combine every element of the first list with every element of the second and third lists.
+// The triples are in lexicographic order, the third element varies fastest.
+// Takes O(n * m * o) time and space.
+// This functions is IMMUTABLE and produces a completely new list!
+func CartesianProduct3[T, U, V any](listA []T, listB []U, listC []V) []Tuple.Tuple3[T, U, V] {
+	new_list := make([]Tuple.Tuple3[T, U, V], 0, len(listA)*len(listB)*len(listC))
+	for _, a := range listA {
+		for _, b := range listB {
+			for _, c := range listC {
+				new_list = append(new_list, Tuple.Triple(a, b, c))
+			}
+		}
+	}
+	return new_list
+}
+
+// Get every way to pick one element from each of the lists, for any amount of lists of the same type.
+// The results are in lexicographic order, the element from the last list varies fastest.
+// The size of the result is the product of the lengths of the lists,
+// use `CartesianProductNIterator` to avoid holding all of them.
+// This functions is IMMUTABLE and produces a completely new list!
+func CartesianProductN[T any](lists [][]T) [][]T {
+	return collect(CartesianProductNIterator(lists))
+}
+
+// Same as `CartesianProductN`, but every result is produced on demand.
+// The iterator returns Nothing once all results have been produced.
+func CartesianProductNIterator[T any](lists [][]T) func() Maybe[[]T] {
+	indexes := make([]int, len(lists))
+	done := Any(IsEmpty[T], lists)
+	return func() Maybe[[]T] {
+		if done {
+			return Nothing[[]T]()
+		}
+		product := make([]T, len(lists))
+		for i, index := range indexes {
+			product[i] = lists[i][index]
+		}
+		// Step the odometer, the last list turns fastest.
+		i := len(indexes) - 1
+		for i >= 0 && indexes[i] == len(lists[i])-1 {
+			indexes[i] = 0
+			i--
+		}
+		if i < 0 {
+			done = true
+		} else {
+			indexes[i]++
+		}
+		return Just(product)
+	}
+}
+
+// Take the elements of the lists in turns: the first element of every list, then the second, and so on.
+// Lists that run out are skipped, so no elements are dropped.
+// This functions is IMMUTABLE and produces a completely new list!
+func Interleave[T any](lists [][]T) []T {
+	length := Foldl(func(list []T, acc int) int { return acc + len(list) }, 0, lists)
+	new_list := make([]T, 0, length)
+	for i := 0; len(new_list) < length; i++ {
+		for _, list := range lists {
+			if i < len(list) {
+				new_list = append(new_list, list[i])
+			}
+		}
+	}
+	return new_list
+}
+
+// Remove the elements of the second list from the first one, counting duplicates.
+// An element appearing n times in the first list and m times in the second, appears max(n - m, 0) times.
+// The first occurrences are removed, the rest keep their order.
+// Takes O(n + m) time.
+// This functions is IMMUTABLE and produces a completely new list!
+func ListDiff[T comparable](listA []T, listB []T) []T {
+	counts := make(map[T]int, len(listB))
+	for _, v := range listB {
+		counts[v]++
+	}
+	new_list := make([]T, 0, len(listA))
+	for _, v := range listA {
+		if counts[v] > 0 {
+			counts[v]--
+		} else {
+			new_list = append(new_list, v)
+		}
+	}
+	return new_list
+}
+
+// Keep the elements of the first list that are in the second one, counting duplicates.
+// An element appearing n times in the first list and m times in the second, appears min(n, m) times.
+// The first occurrences are kept, in the order of the first list.
+// Takes O(n + m) time.
+// This functions is IMMUTABLE and produces a completely new list!
+func ListIntersect[T comparable](listA []T, listB []T) []T {
+	counts := make(map[T]int, len(listB))
+	for _, v := range listB {
+		counts[v]++
+	}
+	new_list := make([]T, 0, Basics.Min(len(listA), len(listB)))
+	for _, v := range listA {
+		if counts[v] > 0 {
+			counts[v]--
+			new_list = append(new_list, v)
+		}
+	}
+	return new_list
+}
+
 // SORT
 
 // Sort values from lowest to highest
@@ -312,6 +974,7 @@ func Sort[T Basics.Ordered](list []T) []T {
 }
 
 // Sort values by a derived property.
+// The sort is NOT stable, use `SortStableBy` to keep the order of equal elements.
 // This functions is IMMUTABLE and produces a completely new list!
 func SortBy[T any, U Basics.Ordered](mapfn func(value T) U, list []T) []T {
 	new_list := clone(list)
@@ -323,6 +986,7 @@ func SortBy[T any, U Basics.Ordered](mapfn func(value T) U, list []T) []T {
 }
 
 // Sort values with a custom comparison function.
+// The sort is NOT stable, use `SortStableWith` to keep the order of equal elements.
 // This functions is IMMUTABLE and produces a completely new list!
 func SortWith[T any](cmpfn func(a, b T) Basics.Order, list []T) []T {
 	new_list := clone(list)
@@ -331,16 +995,622 @@ func SortWith[T any](cmpfn func(a, b T) Basics.Order, list []T) []T {
 	return new_list
 }
 
-// DECONSTRUCT
+// Sort values from lowest to highest, keeping equal elements in their original order.
+// This functions is IMMUTABLE and produces a completely new list!
+func SortStable[T Basics.Ordered](list []T) []T {
+	return SortStableWith(Basics.Compare[T], list)
+}
 
-// Determine if a list is empty.
-func IsEmpty[T any](list []T) bool {
-	return len(list) == 0
+// Sort values by a derived property, keeping equal elements in their original order.
+// Sorting by a second key and then stable sorting by a first key orders by both keys.
+// This functions is IMMUTABLE and produces a completely new list!
+func SortStableBy[T any, U Basics.Ordered](mapfn func(value T) U, list []T) []T {
+	return SortStableWith(Basics.Comparing[T](mapfn), list)
 }
 
-// Extract the first element of a list.
-func Head[T any](list []T) Maybe[T] {
-	if len(list) == 0 {
+// Sort values with a custom comparison function, keeping equal elements in their original order.
+// This functions is IMMUTABLE and produces a completely new list!
+func SortStableWith[T any](cmpfn func(a, b T) Basics.Order, list []T) []T {
+	new_list := clone(list)
+	stableCmpFunc(new_list, len(new_list), cmpfn)
+	return new_list
+}
+
+// SELECTION
+
+// Rearrange data[a:b] so that data[k] holds the element that would be there if data[a:b] was sorted,
+// with no greater elements before it and no smaller elements after it.
+// It is a quickselect on top of the pdqsort partitioning, falling back to heapsort after too many bad pivots.
+func selectCmpFunc[E any](data []E, a, b, k int, cmp func(a, b E) int) {
+	limit := 2 * bits.Len(uint(b-a))
+	for b-a > 12 {
+		if limit == 0 {
+			heapSortCmpFunc(data, a, b, cmp)
+			return
+		}
+		limit--
+		pivot, _ := choosePivotCmpFunc(data, a, b, cmp)
+		mid, _ := partitionCmpFunc(data, a, b, pivot, cmp)
+		if k < mid {
+			b = mid
+		} else if k > mid {
+			a = mid + 1
+		} else {
+			return
+		}
+	}
+	insertionSortCmpFunc(data, a, b, cmp)
+}
+
+// Sort the k smallest elements of the data into data[:k], leaving the rest in no particular order.
+func partialSortCmpFunc[E any](data []E, k int, cmp func(a, b E) int) {
+	n := len(data)
+	k = Basics.Clamp(0, k, n)
+	if k < n {
+		selectCmpFunc(data, 0, n, k, cmp)
+	}
+	pdqsortCmpFunc(data, 0, k, bits.Len(uint(k)), cmp)
+}
+
+// Keep the k best elements of the list, best first, with a heap of at most k elements.
+// Takes O(n log k) time.
+func bestK[T any](k int, better func(a, b T) bool, list []T) []T {
+	k = Basics.Clamp(0, k, len(list))
+	// A heap where every parent is worse than its children, so the root is the worst element kept.
+	heap := make([]T, 0, k)
+	siftDown := func(root, hi int) {
+		for {
+			child := 2*root + 1
+			if child >= hi {
+				return
+			}
+			if child+1 < hi && better(heap[child], heap[child+1]) {
+				child++
+			}
+			if !better(heap[root], heap[child]) {
+				return
+			}
+			heap[root], heap[child] = heap[child], heap[root]
+			root = child
+		}
+	}
+	for _, value := range list {
+		if len(heap) < k {
+			heap = append(heap, value)
+			for i := len(heap) - 1; i > 0 && better(heap[(i-1)/2], heap[i]); i = (i - 1) / 2 {
+				heap[i], heap[(i-1)/2] = heap[(i-1)/2], heap[i]
+			}
+		} else if k > 0 && better(value, heap[0]) {
+			heap[0] = value
+			siftDown(0, k)
+		}
+	}
+	// Move the worst element to the back until the heap is empty, leaving the best element first.
+	for i := len(heap) - 1; i > 0; i-- {
+		heap[0], heap[i] = heap[i], heap[0]
+		siftDown(0, i)
+	}
+	return heap
+}
+
+// Find the element that would be at index n if the list was sorted from lowest to highest.
+// Takes O(n) time on average, which is faster than sorting.
+func NthElement[T Basics.Ordered](n int, list []T) Maybe[T] {
+	return NthElementWith(Basics.Compare[T], n, list)
+}
+
+// Find the element that would be at index n if the list was sorted by a derived property.
+func NthElementBy[T any, U Basics.Ordered](mapfn func(value T) U, n int, list []T) Maybe[T] {
+	return NthElementWith(Basics.Comparing[T](mapfn), n, list)
+}
+
+// Find the element that would be at index n if the list was sorted with the custom comparison function.
+func NthElementWith[T any](cmpfn func(a, b T) Basics.Order, n int, list []T) Maybe[T] {
+	if n < 0 || n >= len(list) {
+		return Nothing[T]()
+	}
+	new_list := clone(list)
+	selectCmpFunc(new_list, 0, len(new_list), n, cmpfn)
+	return Just(new_list[n])
+}
+
+// Get the k largest elements, from highest to lowest.
+// Takes O(n log k) time, which is faster than sorting when k is small.
+// This functions is IMMUTABLE and produces a completely new list!
+func TopK[T Basics.Ordered](k int, list []T) []T {
+	return bestK(k, Basics.Gt[T], list)
+}
+
+// Get the k elements with the largest derived property, from highest to lowest.
+// This functions is IMMUTABLE and produces a completely new list!
+func TopKBy[T any, U Basics.Ordered](mapfn func(value T) U, k int, list []T) []T {
+	return TopKWith(Basics.Comparing[T](mapfn), k, list)
+}
+
+// Get the k largest elements according to the custom comparison function, from highest to lowest.
+// This functions is IMMUTABLE and produces a completely new list!
+func TopKWith[T any](cmpfn func(a, b T) Basics.Order, k int, list []T) []T {
+	return bestK(k, func(a, b T) bool {
+		return cmpfn(a, b) > 0
+	}, list)
+}
+
+// Get the k smallest elements, from lowest to highest.
+// Takes O(n log k) time, which is faster than sorting when k is small.
+// This functions is IMMUTABLE and produces a completely new list!
+func BottomK[T Basics.Ordered](k int, list []T) []T {
+	return bestK(k, Basics.Lt[T], list)
+}
+
+// Get the k elements with the smallest derived property, from lowest to highest.
+// This functions is IMMUTABLE and produces a completely new list!
+func BottomKBy[T any, U Basics.Ordered](mapfn func(value T) U, k int, list []T) []T {
+	return BottomKWith(Basics.Comparing[T](mapfn), k, list)
+}
+
+// Get the k smallest elements according to the custom comparison function, from lowest to highest.
+// This functions is IMMUTABLE and produces a completely new list!
+func BottomKWith[T any](cmpfn func(a, b T) Basics.Order, k int, list []T) []T {
+	return bestK(k, func(a, b T) bool {
+		return cmpfn(a, b) < 0
+	}, list)
+}
+
+// Sort only the k smallest values, from lowest to highest, into the front of the list.
+// The remaining values follow in no particular order.
+// This functions is IMMUTABLE and produces a completely new list!
+func PartialSort[T Basics.Ordered](k int, list []T) []T {
+	return PartialSortWith(Basics.Compare[T], k, list)
+}
+
+// Sort only the k values with the smallest derived property into the front of the list.
+// The remaining values follow in no particular order.
+// This functions is IMMUTABLE and produces a completely new list!
+func PartialSortBy[T any, U Basics.Ordered](mapfn func(value T) U, k int, list []T) []T {
+	return PartialSortWith(Basics.Comparing[T](mapfn), k, list)
+}
+
+// Sort only the k smallest values according to the custom comparison function into the front of the list.
+// The remaining values follow in no particular order.
+// This functions is IMMUTABLE and produces a completely new list!
+func PartialSortWith[T any](cmpfn func(a, b T) Basics.Order, k int, list []T) []T {
+	new_list := clone(list)
+	partialSortCmpFunc(new_list, k, cmpfn)
+	return new_list
+}
+
+// Find the median of a non-empty list of numbers.
+// For an even amount of numbers it is the mean of the two middle numbers.
+func Median[T Basics.Number](list []T) Maybe[float64] {
+	return Quantile(0.5, list)
+}
+
+// Find the q-th quantile of a non-empty list of numbers, where q is between 0 and 1.
+// Values between two numbers are linearly interpolated, so Quantile(0.5, list) is the median.
+func Quantile[T Basics.Number](q float64, list []T) Maybe[float64] {
+	if len(list) == 0 || q < 0 || q > 1 || math.IsNaN(q) {
+		return Nothing[float64]()
+	}
+	new_list := clone(list)
+	position := q * float64(len(new_list)-1)
+	index := int(position)
+	selectCmpFunc(new_list, 0, len(new_list), index, Basics.Compare[T])
+	lower := float64(new_list[index])
+	fraction := position - float64(index)
+	if fraction == 0 {
+		return Just(lower)
+	}
+	// Every element after the index is at least as large, the smallest of them is the next in order.
+	upper := float64(Basics.Min(new_list[index+1], new_list[index+2:]...))
+	return Just(lower + fraction*(upper-lower))
+}
+
+// DIFF
+
+// The kind of a step in an edit script.
+type EditOp int
+
+const (
+	EditKeep EditOp = iota
+	EditDelete
+	EditInsert
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case EditKeep:
+		return " "
+	case EditDelete:
+		return "-"
+	default:
+		return "+"
+	}
+}
+
+// A single step of an edit script that turns an old list into a new list.
+// OldIndex is the index of the element in the old list, it is -1 for an inserted element.
+// NewIndex is the index of the element in the new list, it is -1 for a deleted element.
+type Edit[T any] struct {
+	Op       EditOp
+	OldIndex int
+	NewIndex int
+	Value    T
+}
+
+func (e Edit[T]) String() string {
+	return fmt.Sprintf("%s%v", e.Op, e.Value)
+}
+
+// Find a shortest edit script that turns the first list into the second list.
+// The script holds every element of both lists once: kept elements in order, with the deletions and insertions between them.
+// Deletions come before insertions at the same position.
+// Uses the algorithm of Myers, which takes O((n + m) * d) time and O(d^2) space, where d is the amount of edits.
+// This functions is IMMUTABLE and produces a completely new list!
+func Diff[T comparable](listA []T, listB []T) []Edit[T] {
+	return DiffWith(Basics.Eq[T], listA, listB)
+}
+
+// Same as `Diff`, comparing elements with the given equality function.
+// This functions is IMMUTABLE and produces a completely new list!
+func DiffWith[T any](eqfn func(a, b T) bool, listA []T, listB []T) []Edit[T] {
+	n, m := len(listA), len(listB)
+	// v[k] is the furthest x reached on diagonal k = x - y, diagonals are offset to be valid indexes.
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	// trace[d] holds the diagonals -d..d of v before step d.
+	trace := make([][]int, 0, 8)
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, clone(v[offset-d:offset+d+1]))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && eqfn(listA[x], listB[y]) {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrackEdits(trace, listA, listB)
+			}
+		}
+	}
+	return []Edit[T]{}
+}
+
+// Walk back through the trace of the Myers algorithm, collecting the edits from the end to the start.
+func backtrackEdits[T any](trace [][]int, listA []T, listB []T) []Edit[T] {
+	x, y := len(listA), len(listB)
+	edits := make([]Edit[T], 0, x+y)
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		get := func(k int) int { return v[k+d] }
+		k := x - y
+		var prev_k int
+		if k == -d || (k != d && get(k-1) < get(k+1)) {
+			prev_k = k + 1
+		} else {
+			prev_k = k - 1
+		}
+		prev_x := 0
+		if d > 0 {
+			prev_x = get(prev_k)
+		}
+		prev_y := prev_x - prev_k
+		for x > prev_x && y > prev_y && x > 0 && y > 0 {
+			x--
+			y--
+			edits = append(edits, Edit[T]{EditKeep, x, y, listA[x]})
+		}
+		if d == 0 {
+			break
+		}
+		if x == prev_x {
+			edits = append(edits, Edit[T]{EditInsert, -1, prev_y, listB[prev_y]})
+		} else {
+			edits = append(edits, Edit[T]{EditDelete, prev_x, -1, listA[prev_x]})
+		}
+		x, y = prev_x, prev_y
+	}
+	return Reverse_mut(edits)
+}
+
+// Apply an edit script made by `Diff` to a list.
+// Fails if the script does not match the list: a kept or deleted element is not at its index or has a different value,
+// or the script does not cover the whole list.
+// This functions is IMMUTABLE and produces a completely new list!
+func Patch[T comparable](edits []Edit[T], list []T) Result[[]T] {
+	return PatchWith(Basics.Eq[T], edits, list)
+}
+
+// Same as `Patch`, comparing elements with the given equality function.
+// This functions is IMMUTABLE and produces a completely new list!
+func PatchWith[T any](eqfn func(a, b T) bool, edits []Edit[T], list []T) Result[[]T] {
+	new_list := make([]T, 0, len(list))
+	i := 0
+	for _, edit := range edits {
+		if edit.Op == EditInsert {
+			new_list = append(new_list, edit.Value)
+			continue
+		}
+		if edit.OldIndex != i || i >= len(list) || !eqfn(list[i], edit.Value) {
+			return Errf[[]T]("Edit %v at index %d does not match the list at index %d.", edit, edit.OldIndex, i)
+		}
+		if edit.Op == EditKeep {
+			new_list = append(new_list, list[i])
+		}
+		i++
+	}
+	if i != len(list) {
+		return Errf[[]T]("Edit script stops at index %d of a list of length %d.", i, len(list))
+	}
+	return Ok(new_list)
+}
+
+// Find a longest list of elements that appear in both lists in the same order, not necessarily next to each other.
+// Takes O((n + m) * d) time, where d is the amount of elements that are only in one of the lists.
+// This functions is IMMUTABLE and produces a completely new list!
+func LongestCommonSubsequence[T comparable](listA []T, listB []T) []T {
+	return LongestCommonSubsequenceWith(Basics.Eq[T], listA, listB)
+}
+
+// Same as `LongestCommonSubsequence`, comparing elements with the given equality function.
+// The elements are taken from the first list.
+// This functions is IMMUTABLE and produces a completely new list!
+func LongestCommonSubsequenceWith[T any](eqfn func(a, b T) bool, listA []T, listB []T) []T {
+	return FilterMap(func(edit Edit[T]) Maybe[T] {
+		if edit.Op == EditKeep {
+			return Just(edit.Value)
+		}
+		return Nothing[T]()
+	}, DiffWith(eqfn, listA, listB))
+}
+
+// Count the least amount of single element insertions, deletions and substitutions that turn the first list into the second.
+// Takes O(n * m) time and O(min(n, m)) space.
+func LevenshteinDistance[T comparable](listA []T, listB []T) int {
+	return LevenshteinDistanceWith(Basics.Eq[T], listA, listB)
+}
+
+// Same as `LevenshteinDistance`, comparing elements with the given equality function.
+func LevenshteinDistanceWith[T any](eqfn func(a, b T) bool, listA []T, listB []T) int {
+	if len(listA) < len(listB) {
+		// Keep the row as short as possible.
+		return LevenshteinDistanceWith(func(b, a T) bool { return eqfn(a, b) }, listB, listA)
+	}
+	// row[j] is the distance between the processed prefix of listA and listB[:j].
+	row := Range(0, len(listB))
+	for i, a := range listA {
+		diagonal := row[0]
+		row[0] = i + 1
+		for j, b := range listB {
+			cost := 1
+			if eqfn(a, b) {
+				cost = 0
+			}
+			diagonal, row[j+1] = row[j+1], Basics.Min(row[j+1]+1, row[j]+1, diagonal+cost)
+		}
+	}
+	return row[len(listB)]
+}
+
+// SORTED LISTS
+
+// Find the first index in a sorted list whose element is not less than the value.
+// This is where the value would be inserted to keep the list sorted.
+// Takes O(log n) time.
+func LowerBound[T Basics.Ordered](value T, list []T) int {
+	return LowerBoundWith(Basics.Compare[T], value, list)
+}
+
+// Same as `LowerBound`, for a list sorted with the given comparison function.
+func LowerBoundWith[T any](cmpfn func(a, b T) Basics.Order, value T, list []T) int {
+	low, high := 0, len(list)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if cmpfn(list[mid], value) < 0 {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low
+}
+
+// Find the first index in a sorted list whose element is greater than the value.
+// Takes O(log n) time.
+func UpperBound[T Basics.Ordered](value T, list []T) int {
+	return UpperBoundWith(Basics.Compare[T], value, list)
+}
+
+// Same as `UpperBound`, for a list sorted with the given comparison function.
+func UpperBoundWith[T any](cmpfn func(a, b T) Basics.Order, value T, list []T) int {
+	low, high := 0, len(list)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if cmpfn(list[mid], value) <= 0 {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low
+}
+
+// Find the range of indexes in a sorted list whose elements are equal to the value.
+// The range starts at the first index and stops before the second one, it is empty when the value is not found.
+// Takes O(log n) time.
+func EqualRange[T Basics.Ordered](value T, list []T) (int, int) {
+	return EqualRangeWith(Basics.Compare[T], value, list)
+}
+
+// Same as `EqualRange`, for a list sorted with the given comparison function.
+func EqualRangeWith[T any](cmpfn func(a, b T) Basics.Order, value T, list []T) (int, int) {
+	return LowerBoundWith(cmpfn, value, list), UpperBoundWith(cmpfn, value, list)
+}
+
+// Find the index of the value in a sorted list.
+// If the value appears many times, the first index is returned.
+// Takes O(log n) time.
+func BinarySearch[T Basics.Ordered](value T, list []T) Maybe[int] {
+	return BinarySearchWith(Basics.Compare[T], value, list)
+}
+
+// Find the index of the element with the given key in a list sorted by that key.
+// If the key appears many times, the first index is returned.
+func BinarySearchBy[T any, U Basics.Ordered](mapfn func(value T) U, key U, list []T) Maybe[int] {
+	low, high := 0, len(list)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if mapfn(list[mid]) < key {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	if low < len(list) && mapfn(list[low]) == key {
+		return Just(low)
+	}
+	return Nothing[int]()
+}
+
+// Same as `BinarySearch`, for a list sorted with the given comparison function.
+func BinarySearchWith[T any](cmpfn func(a, b T) Basics.Order, value T, list []T) Maybe[int] {
+	index := LowerBoundWith(cmpfn, value, list)
+	if index < len(list) && cmpfn(list[index], value) == 0 {
+		return Just(index)
+	}
+	return Nothing[int]()
+}
+
+// Insert a value into a sorted list, keeping it sorted.
+// The value is inserted after any elements equal to it.
+// This functions is IMMUTABLE and produces a completely new list!
+func InsertSorted[T Basics.Ordered](value T, list []T) []T {
+	return InsertSortedWith(Basics.Compare[T], value, list)
+}
+
+// Same as `InsertSorted`, for a list sorted with the given comparison function.
+// This functions is IMMUTABLE and produces a completely new list!
+func InsertSortedWith[T any](cmpfn func(a, b T) Basics.Order, value T, list []T) []T {
+	index := UpperBoundWith(cmpfn, value, list)
+	new_list := make([]T, 0, len(list)+1)
+	new_list = append(new_list, list[:index]...)
+	new_list = append(new_list, value)
+	return append(new_list, list[index:]...)
+}
+
+// Merge two sorted lists into one sorted list.
+// When elements are equal, the ones from the first list come first.
+// This functions is IMMUTABLE and produces a completely new list!
+func MergeSorted[T Basics.Ordered](listA []T, listB []T) []T {
+	new_list := make([]T, len(listA)+len(listB))
+	mergeInto(new_list, listA, listB, Basics.Lt[T])
+	return new_list
+}
+
+// Same as `MergeSorted`, for lists sorted with the given comparison function.
+// This functions is IMMUTABLE and produces a completely new list!
+func MergeSortedWith[T any](cmpfn func(a, b T) Basics.Order, listA []T, listB []T) []T {
+	new_list := make([]T, len(listA)+len(listB))
+	mergeInto(new_list, listA, listB, func(a, b T) bool {
+		return cmpfn(a, b) < 0
+	})
+	return new_list
+}
+
+// Walk two sorted lists side by side, calling the matching function for every element
+// that only appears in the first, only in the second, or in both lists.
+// Duplicates are paired up one by one, so an element appearing twice in the first list
+// and once in the second list is once in both, and once only in the first.
+func walkSorted[T any](cmpfn func(a, b T) Basics.Order, listA []T, listB []T, onlyA, onlyB, both func(value T)) {
+	i, j := 0, 0
+	for i < len(listA) && j < len(listB) {
+		switch order := cmpfn(listA[i], listB[j]); {
+		case order < 0:
+			onlyA(listA[i])
+			i++
+		case order > 0:
+			onlyB(listB[j])
+			j++
+		default:
+			both(listA[i])
+			i++
+			j++
+		}
+	}
+	for ; i < len(listA); i++ {
+		onlyA(listA[i])
+	}
+	for ; j < len(listB); j++ {
+		onlyB(listB[j])
+	}
+}
+
+// Get the union of two sorted lists, which is sorted as well.
+// An element appearing n times in one list and m times in the other, appears max(n, m) times.
+// This functions is IMMUTABLE and produces a completely new list!
+func SortedUnion[T Basics.Ordered](listA []T, listB []T) []T {
+	return SortedUnionWith(Basics.Compare[T], listA, listB)
+}
+
+// Same as `SortedUnion`, for lists sorted with the given comparison function.
+// When elements are equal, the ones from the first list are kept.
+// This functions is IMMUTABLE and produces a completely new list!
+func SortedUnionWith[T any](cmpfn func(a, b T) Basics.Order, listA []T, listB []T) []T {
+	new_list := make([]T, 0, len(listA)+len(listB))
+	keep := func(value T) { new_list = append(new_list, value) }
+	walkSorted(cmpfn, listA, listB, keep, keep, keep)
+	return new_list
+}
+
+// Get the intersection of two sorted lists, which is sorted as well.
+// An element appearing n times in one list and m times in the other, appears min(n, m) times.
+// This functions is IMMUTABLE and produces a completely new list!
+func SortedIntersect[T Basics.Ordered](listA []T, listB []T) []T {
+	return SortedIntersectWith(Basics.Compare[T], listA, listB)
+}
+
+// Same as `SortedIntersect`, for lists sorted with the given comparison function.
+// The elements of the first list are kept.
+// This functions is IMMUTABLE and produces a completely new list!
+func SortedIntersectWith[T any](cmpfn func(a, b T) Basics.Order, listA []T, listB []T) []T {
+	new_list := make([]T, 0, Basics.Min(len(listA), len(listB)))
+	skip := func(value T) {}
+	walkSorted(cmpfn, listA, listB, skip, skip, func(value T) { new_list = append(new_list, value) })
+	return new_list
+}
+
+// Get the elements of the first sorted list that are not in the second one, which is sorted as well.
+// An element appearing n times in the first list and m times in the second, appears max(n - m, 0) times.
+// This functions is IMMUTABLE and produces a completely new list!
+func SortedDiff[T Basics.Ordered](listA []T, listB []T) []T {
+	return SortedDiffWith(Basics.Compare[T], listA, listB)
+}
+
+// Same as `SortedDiff`, for lists sorted with the given comparison function.
+// This functions is IMMUTABLE and produces a completely new list!
+func SortedDiffWith[T any](cmpfn func(a, b T) Basics.Order, listA []T, listB []T) []T {
+	new_list := make([]T, 0, len(listA))
+	skip := func(value T) {}
+	walkSorted(cmpfn, listA, listB, func(value T) { new_list = append(new_list, value) }, skip, skip)
+	return new_list
+}
+
+// DECONSTRUCT
+
+// Determine if a list is empty.
+func IsEmpty[T any](list []T) bool {
+	return len(list) == 0
+}
+
+// Extract the first element of a list.
+func Head[T any](list []T) Maybe[T] {
+	if len(list) == 0 {
 		return Nothing[T]()
 	}
 	return Just(list[0])
@@ -354,6 +1624,30 @@ func Tail[T any](list []T) Maybe[[]T] {
 	return Just(list[1:])
 }
 
+// Extract the last element of a list.
+func Last[T any](list []T) Maybe[T] {
+	if len(list) == 0 {
+		return Nothing[T]()
+	}
+	return Just(list[len(list)-1])
+}
+
+// Extract everything but the last element of a list.
+func Init[T any](list []T) Maybe[[]T] {
+	if len(list) == 0 {
+		return Nothing[[]T]()
+	}
+	return Just(list[:len(list)-1])
+}
+
+// Split a list into its first element and the rest of the list.
+func Uncons[T any](list []T) Maybe[Tuple.Tuple[T, []T]] {
+	if len(list) == 0 {
+		return Nothing[Tuple.Tuple[T, []T]]()
+	}
+	return Just(Tuple.Pair(list[0], list[1:]))
+}
+
 // Take the first n members of a list.
 func Take[N Basics.Int, T any](n N, list []T) []T {
 	return list[0:n]
@@ -364,6 +1658,35 @@ func Drop[N Basics.Int, T any](n N, list []T) []T {
 	return list[n:]
 }
 
+// Take elements from the front of a list as long as they satisfy the test.
+func TakeWhile[T any](testfn func(value T) bool, list []T) []T {
+	taken, _ := Span(testfn, list)
+	return taken
+}
+
+// Drop elements from the front of a list as long as they satisfy the test.
+func DropWhile[T any](testfn func(value T) bool, list []T) []T {
+	_, rest := Span(testfn, list)
+	return rest
+}
+
+// Split a list at the first element that does not satisfy the test.
+// The first list is the longest prefix of elements that satisfy the test, the second list is the rest.
+func Span[T any](testfn func(value T) bool, list []T) ([]T, []T) {
+	i := 0
+	for i < len(list) && testfn(list[i]) {
+		i++
+	}
+	return list[:i], list[i:]
+}
+
+// Split a list at the index, the first list gets the first n elements and the second list gets the rest.
+// The index is clamped to the bounds of the list.
+func SplitAt[T any](n int, list []T) ([]T, []T) {
+	n = Basics.Clamp(0, n, len(list))
+	return list[:n], list[n:]
+}
+
 // Partition a list based on some test. The first list contains all values that satisfy the test, and the second list contains all the value that do not.
 func Partition[T any](testfn func(value T) bool, list []T) ([]T, []T) {
 	length := len(list)
@@ -390,6 +1713,120 @@ func Unzip[T, U any](list []Tuple.Tuple[T, U]) Tuple.Tuple[[]T, []U] {
 	return Tuple.Pair(list1, list2)
 }
 
+// Decompose a list of 3-tuples into a 3-tuple of lists.
+func Unzip3[T, U, V any](list []Tuple.Tuple3[T, U, V]) Tuple.Tuple3[[]T, []U, []V] {
+	list1 := make([]T, len(list))
+	list2 := make([]U, len(list))
+	list3 := make([]V, len(list))
+	for i, v := range list {
+		list1[i] = v.Fst
+		list2[i] = v.Snd
+		list3[i] = v.Thd
+	}
+	return Tuple.Triple(list1, list2, list3)
+}
+
+// Decompose a list of 4-tuples into a 4-tuple of lists.
+func Unzip4[T, U, V, W any](list []Tuple.Tuple4[T, U, V, W]) Tuple.Tuple4[[]T, []U, []V, []W] {
+	list1 := make([]T, len(list))
+	list2 := make([]U, len(list))
+	list3 := make([]V, len(list))
+	list4 := make([]W, len(list))
+	for i, v := range list {
+		list1[i] = v.Fst
+		list2[i] = v.Snd
+		list3[i] = v.Thd
+		list4[i] = v.Fth
+	}
+	return Tuple.Quadruple(list1, list2, list3, list4)
+}
+
+// Decompose a list of 5-tuples into a 5-tuple of lists.
+func Unzip5[T, U, V, W, X any](list []Tuple.Tuple5[T, U, V, W, X]) Tuple.Tuple5[[]T, []U, []V, []W, []X] {
+	list1 := make([]T, len(list))
+	list2 := make([]U, len(list))
+	list3 := make([]V, len(list))
+	list4 := make([]W, len(list))
+	list5 := make([]X, len(list))
+	for i, v := range list {
+		list1[i] = v.Fst
+		list2[i] = v.Snd
+		list3[i] = v.Thd
+		list4[i] = v.Fth
+		list5[i] = v.Fif
+	}
+	return Tuple.Quintuple(list1, list2, list3, list4, list5)
+}
+
+// SEARCH
+
+// Find the index of the first element that satisfies the test.
+func FindIndex[T any](testfn func(value T) bool, list []T) Maybe[int] {
+	for i, v := range list {
+		if testfn(v) {
+			return Just(i)
+		}
+	}
+	return Nothing[int]()
+}
+
+// Find the index of the first occurrence of a value.
+func ElemIndex[T comparable](value T, list []T) Maybe[int] {
+	return FindIndex(func(v T) bool { return v == value }, list)
+}
+
+// Find the indexes of all the elements that satisfy the test, in order.
+// This functions is IMMUTABLE and produces a completely new list!
+func Indices[T any](testfn func(value T) bool, list []T) []int {
+	new_list := make([]int, 0, len(list))
+	for i, v := range list {
+		if testfn(v) {
+			new_list = append(new_list, i)
+		}
+	}
+	return new_list
+}
+
+// Look up the value paired with the key in a list of key-value pairs.
+// If the key appears many times, the value of the first pair is returned.
+func Lookup[K comparable, V any](key K, list []Tuple.Tuple[K, V]) Maybe[V] {
+	for _, pair := range list {
+		if pair.Fst == key {
+			return Just(pair.Snd)
+		}
+	}
+	return Nothing[V]()
+}
+
+// EITHERS
+
+// Keep the values of all the `Left` variants, in order.
+// This functions is IMMUTABLE and produces a completely new list!
+func Lefts[L, R any](list []Either.Either[L, R]) []L {
+	return FilterMap(Either.Either[L, R].Left, list)
+}
+
+// Keep the values of all the `Right` variants, in order.
+// This functions is IMMUTABLE and produces a completely new list!
+func Rights[L, R any](list []Either.Either[L, R]) []R {
+	return FilterMap(Either.Either[L, R].Right, list)
+}
+
+// Split a list of `Either` into the values of the `Left` variants and the values of the `Right` variants, in order.
+// This functions is IMMUTABLE and produces completely new lists!
+func PartitionEithers[L, R any](list []Either.Either[L, R]) ([]L, []R) {
+	lefts := make([]L, 0, len(list))
+	rights := make([]R, 0, len(list))
+	for _, e := range list {
+		if e.IsRight() {
+			rights = append(rights, e.Right().Expect())
+		} else {
+			lefts = append(lefts, e.Left().Expect())
+		}
+	}
+	return lefts, rights
+}
+
 // FROM ARRAY
 
 // CREATE
@@ -63,6 +63,83 @@ func Reverse_mut[T any](list []T) []T {
 	return list
 }
 
+// UTILITIES
+
+// Remove duplicate elements, keeping the first occurrence of each.
+// This functions is MUTABLE and will change the list in place.
+func Unique_mut[T comparable](list []T) []T {
+	return UniqueBy_mut(func(value T) T { return value }, list)
+}
+
+// Remove elements with a duplicate derived property, keeping the first occurrence of each.
+// This functions is MUTABLE and will change the list in place.
+func UniqueBy_mut[T any, U comparable](mapfn func(value T) U, list []T) []T {
+	seen := make(map[U]struct{}, len(list))
+	return Filter_mut(func(value T) bool {
+		key := mapfn(value)
+		if _, ok := seen[key]; ok {
+			return false
+		}
+		seen[key] = struct{}{}
+		return true
+	}, list)
+}
+
+// Remove the first occurrence of a value from a list.
+// This functions is MUTABLE and will change the list in place.
+func Remove_mut[T comparable](value T, list []T) []T {
+	return RemoveAt_mut(ElemIndex(value, list).WithDefault(-1), list)
+}
+
+// Remove the element at the index.
+// If the index is out of range, the list is unaltered.
+// This functions is MUTABLE and will change the list in place.
+func RemoveAt_mut[T any](index int, list []T) []T {
+	if index < 0 || index >= len(list) {
+		return list
+	}
+	copy(list[index:], list[index+1:])
+	var zero T
+	list[len(list)-1] = zero
+	return list[:len(list)-1]
+}
+
+// Insert an element at the index, moving the elements from that index onward one place back.
+// An index equal to the length of the list appends the element.
+// If the index is out of range, the list is unaltered.
+// This functions is MUTABLE and will change the list in place.
+func InsertAt_mut[T any](index int, value T, list []T) []T {
+	if index < 0 || index > len(list) {
+		return list
+	}
+	var zero T
+	list = append(list, zero)
+	copy(list[index+1:], list[index:])
+	list[index] = value
+	return list
+}
+
+// Apply a function to every element that satisfies the test, leaving the other elements as they are.
+// This functions is MUTABLE and will change the list in place.
+func Update_mut[T any](testfn func(value T) bool, mapfn func(value T) T, list []T) []T {
+	for i, v := range list {
+		if testfn(v) {
+			list[i] = mapfn(v)
+		}
+	}
+	return list
+}
+
+// Apply a function to the element at the index.
+// If the index is out of range, the list is unaltered.
+// This functions is MUTABLE and will change the list in place.
+func UpdateAt_mut[T any](index int, mapfn func(value T) T, list []T) []T {
+	if index >= 0 && index < len(list) {
+		list[index] = mapfn(list[index])
+	}
+	return list
+}
+
 // COMBINE
 
 // Put two lists together.
@@ -134,6 +211,7 @@ func Sort_mut[T Basics.Ordered](list []T) []T {
 }
 
 // Sort values by a derived property.
+// The sort is NOT stable, use `SortStableBy_mut` to keep the order of equal elements.
 // This functions is MUTABLE and will change the list in place.
 func SortBy_mut[T any, U Basics.Ordered](mapfn func(value T) U, list []T) []T {
 	n := len(list)
@@ -144,6 +222,7 @@ func SortBy_mut[T any, U Basics.Ordered](mapfn func(value T) U, list []T) []T {
 }
 
 // Sort values with a custom comparison function.
+// The sort is NOT stable, use `SortStableWith_mut` to keep the order of equal elements.
 // This functions is MUTABLE and will change the list in place.
 func SortWith_mut[T any](cmpfn func(a, b T) Basics.Order, list []T) []T {
 	n := len(list)
@@ -151,6 +230,90 @@ func SortWith_mut[T any](cmpfn func(a, b T) Basics.Order, list []T) []T {
 	return list
 }
 
+// Sort values from lowest to highest, keeping equal elements in their original order.
+// This functions is MUTABLE and will change the list in place.
+func SortStable_mut[T Basics.Ordered](list []T) []T {
+	return SortStableWith_mut(Basics.Compare[T], list)
+}
+
+// Sort values by a derived property, keeping equal elements in their original order.
+// This functions is MUTABLE and will change the list in place.
+func SortStableBy_mut[T any, U Basics.Ordered](mapfn func(value T) U, list []T) []T {
+	return SortStableWith_mut(Basics.Comparing[T](mapfn), list)
+}
+
+// Sort values with a custom comparison function, keeping equal elements in their original order.
+// This functions is MUTABLE and will change the list in place.
+func SortStableWith_mut[T any](cmpfn func(a, b T) Basics.Order, list []T) []T {
+	stableCmpFunc(list, len(list), cmpfn)
+	return list
+}
+
+// SELECTION
+
+// Rearrange the list so that the element at index n is the one that would be there if the list was sorted,
+// with no greater elements before it and no smaller elements after it.
+// Takes O(n) time on average, which is faster than sorting.
+// This functions is MUTABLE and will change the list in place.
+func NthElement_mut[T Basics.Ordered](n int, list []T) []T {
+	return NthElementWith_mut(Basics.Compare[T], n, list)
+}
+
+// Same as `NthElement_mut` but sorting by a derived property.
+// This functions is MUTABLE and will change the list in place.
+func NthElementBy_mut[T any, U Basics.Ordered](mapfn func(value T) U, n int, list []T) []T {
+	return NthElementWith_mut(Basics.Comparing[T](mapfn), n, list)
+}
+
+// Same as `NthElement_mut` but sorting with a custom comparison function.
+// If n is out of range, the list is unaltered.
+// This functions is MUTABLE and will change the list in place.
+func NthElementWith_mut[T any](cmpfn func(a, b T) Basics.Order, n int, list []T) []T {
+	if n >= 0 && n < len(list) {
+		selectCmpFunc(list, 0, len(list), n, cmpfn)
+	}
+	return list
+}
+
+// Sort only the k smallest values, from lowest to highest, into the front of the list.
+// The remaining values follow in no particular order.
+// This functions is MUTABLE and will change the list in place.
+func PartialSort_mut[T Basics.Ordered](k int, list []T) []T {
+	return PartialSortWith_mut(Basics.Compare[T], k, list)
+}
+
+// Sort only the k values with the smallest derived property into the front of the list.
+// This functions is MUTABLE and will change the list in place.
+func PartialSortBy_mut[T any, U Basics.Ordered](mapfn func(value T) U, k int, list []T) []T {
+	return PartialSortWith_mut(Basics.Comparing[T](mapfn), k, list)
+}
+
+// Sort only the k smallest values according to the custom comparison function into the front of the list.
+// This functions is MUTABLE and will change the list in place.
+func PartialSortWith_mut[T any](cmpfn func(a, b T) Basics.Order, k int, list []T) []T {
+	partialSortCmpFunc(list, k, cmpfn)
+	return list
+}
+
+// SORTED LISTS
+
+// Insert a value into a sorted list, keeping it sorted.
+// The value is inserted after any elements equal to it.
+// This functions is MUTABLE and will change the list in place.
+func InsertSorted_mut[T Basics.Ordered](value T, list []T) []T {
+	return InsertSortedWith_mut(Basics.Compare[T], value, list)
+}
+
+// Same as `InsertSorted_mut`, for a list sorted with the given comparison function.
+// This functions is MUTABLE and will change the list in place.
+func InsertSortedWith_mut[T any](cmpfn func(a, b T) Basics.Order, value T, list []T) []T {
+	index := UpperBoundWith(cmpfn, value, list)
+	list = append(list, value)
+	copy(list[index+1:], list[index:])
+	list[index] = value
+	return list
+}
+
 // FROM ARRAY
 
 // Set the element at a particular index. Returns an updated array.
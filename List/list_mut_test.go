@@ -0,0 +1,66 @@
+package List_test
+
+import (
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	"github.com/manwitha1000names/gofp/v3/List"
+)
+
+func TestSortStable_mut(t *testing.T) {
+	list := []int{3, 1, 2}
+	sorted := List.SortStable_mut(list)
+	assertEqual(t, "SortStable_mut", sorted, []int{1, 2, 3})
+	assertEqual(t, "the list after SortStable_mut", list, []int{1, 2, 3})
+
+	people := []person{{"ann", 30}, {"bob", 25}, {"cid", 30}, {"dan", 25}}
+	List.SortStableBy_mut(func(p person) int { return p.age }, people)
+	assertEqual(t, "SortStableBy_mut", people, []person{{"bob", 25}, {"dan", 25}, {"ann", 30}, {"cid", 30}})
+}
+
+func TestInsertSorted_mut(t *testing.T) {
+	list := make([]int, 3, 8)
+	copy(list, []int{1, 3, 5})
+	list = List.InsertSorted_mut(4, list)
+	assertEqual(t, "InsertSorted_mut", list, []int{1, 3, 4, 5})
+	list = List.InsertSorted_mut(6, list)
+	assertEqual(t, "InsertSorted_mut at the back", list, []int{1, 3, 4, 5, 6})
+	assertEqual(t, "InsertSorted_mut into an empty list", List.InsertSorted_mut(1, []int{}), []int{1})
+	assertEqual(t, "InsertSortedWith_mut",
+		List.InsertSortedWith_mut(Basics.Reversed(Basics.Compare[int]), 4, []int{5, 3, 1}), []int{5, 4, 3, 1})
+}
+
+func TestNthElement_mut(t *testing.T) {
+	list := []int{9, 1, 8, 2, 7, 3, 6, 4, 5}
+	List.NthElement_mut(4, list)
+	assertEqual(t, "the nth element after NthElement_mut", list[4], 5)
+	for i, value := range list {
+		if (i < 4 && value > 5) || (i > 4 && value < 5) {
+			t.Errorf("NthElement_mut left %d at index %d", value, i)
+		}
+	}
+	unaltered := []int{3, 1, 2}
+	assertEqual(t, "NthElement_mut out of range", List.NthElement_mut(3, unaltered), []int{3, 1, 2})
+
+	partial := List.PartialSort_mut(2, []int{4, 3, 2, 1})
+	assertEqual(t, "the sorted front of PartialSort_mut", partial[:2], []int{1, 2})
+}
+
+func TestListExtra_mut(t *testing.T) {
+	assertEqual(t, "Unique_mut", List.Unique_mut([]int{3, 1, 3, 2, 1}), []int{3, 1, 2})
+	assertEqual(t, "UniqueBy_mut", List.UniqueBy_mut(func(n int) int { return n % 2 }, []int{3, 1, 2}), []int{3, 2})
+	assertEqual(t, "Remove_mut", List.Remove_mut(3, []int{1, 3, 2, 3}), []int{1, 2, 3})
+	assertEqual(t, "Remove_mut of a missing value", List.Remove_mut(9, []int{1}), []int{1})
+	assertEqual(t, "RemoveAt_mut", List.RemoveAt_mut(0, []int{1, 2}), []int{2})
+	assertEqual(t, "RemoveAt_mut out of range", List.RemoveAt_mut(2, []int{1, 2}), []int{1, 2})
+	assertEqual(t, "InsertAt_mut", List.InsertAt_mut(1, 9, []int{1, 2}), []int{1, 9, 2})
+	assertEqual(t, "InsertAt_mut at the end", List.InsertAt_mut(2, 9, []int{1, 2}), []int{1, 2, 9})
+	assertEqual(t, "InsertAt_mut out of range", List.InsertAt_mut(3, 9, []int{1, 2}), []int{1, 2})
+
+	list := []int{1, 2, 3}
+	List.Update_mut(func(n int) bool { return n > 1 }, Basics.Negate[int], list)
+	assertEqual(t, "the list after Update_mut", list, []int{1, -2, -3})
+	List.UpdateAt_mut(0, Basics.Negate[int], list)
+	List.UpdateAt_mut(3, Basics.Negate[int], list)
+	assertEqual(t, "the list after UpdateAt_mut", list, []int{-1, -2, -3})
+}
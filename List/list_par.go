@@ -1,6 +1,8 @@
 package List
 
 import (
+	"math/bits"
+	"runtime"
 	"sync"
 
 	"github.com/manwitha1000names/gofp/v3/Basics"
@@ -26,6 +28,27 @@ func Map_par[T, U any](mapfn func(value T) U, list []T) []U {
 	return new_list
 }
 
+// Apply a function to every element of a list, running one goroutine per chunk of the given size
+// instead of one goroutine per element. Use it when the function is cheap and the list is long.
+// This functions is IMMUTABLE and produces a completely new list!
+// Ordering IS preserved!
+func MapChunked_par[T, U any](size int, mapfn func(value T) U, list []T) []U {
+	new_list := make([]U, len(list))
+	chunks := Chunk_view(size, new_list)
+	var wg sync.WaitGroup
+	wg.Add(len(chunks))
+	for i, chunk := range chunks {
+		go func(start int, chunk []U) {
+			defer wg.Done()
+			for j := range chunk {
+				chunk[j] = mapfn(list[start+j])
+			}
+		}(i*Basics.Max(size, 1), chunk)
+	}
+	wg.Wait()
+	return new_list
+}
+
 // Same as map but the function is also applied to the index of each element (starting at zero).
 // This functions is IMMUTABLE and produces a completely new list!
 // Ordering IS preserved!
@@ -105,6 +128,96 @@ func FilterMap_par[T, U any](testmapfn func(value T) Maybe[U], list []T) []U {
 	return new_list
 }
 
+// REDUCE
+
+// The smallest amount of elements a parallel reduction hands to a single goroutine.
+const minParChunkSize = 2048
+
+// Split the list into contiguous chunks, one per available CPU,
+// but never smaller than minParChunkSize elements.
+func parChunks[T any](list []T) [][]T {
+	size := Basics.Max(minParChunkSize, (len(list)+runtime.GOMAXPROCS(0)-1)/runtime.GOMAXPROCS(0))
+	chunks := make([][]T, 0, len(list)/size+1)
+	for start := 0; start < len(list); start += size {
+		chunks = append(chunks, list[start:Basics.Min(start+size, len(list))])
+	}
+	return chunks
+}
+
+// Reduce every chunk of the list in parallel, returning the partial results in order.
+func reduceChunks_par[T, U any](reducefn func(chunk []T) U, list []T) []U {
+	chunks := parChunks(list)
+	partials := make([]U, len(chunks))
+	var wg sync.WaitGroup
+	wg.Add(len(chunks))
+	for i, chunk := range chunks {
+		go func(i int, chunk []T) {
+			defer wg.Done()
+			partials[i] = reducefn(chunk)
+		}(i, chunk)
+	}
+	wg.Wait()
+	return partials
+}
+
+// Reduce a list from the left, in parallel.
+// The list is split into chunks that are each folded starting from the identity value of the Monoid,
+// the partial results are then combined in order with the Monoid.
+// The reduce function MUST agree with the Monoid,
+// so that folding a value into an accumulator is the same as combining the accumulator with it.
+func Foldl_par[T, U any](reducefn func(value T, accumulator U) U, monoid Basics.Monoid[U], list []T) U {
+	partials := reduceChunks_par(func(chunk []T) U {
+		return Foldl(reducefn, monoid.Empty(), chunk)
+	}, list)
+	return Foldl(func(partial U, acc U) U {
+		return monoid.Combine(acc, partial)
+	}, monoid.Empty(), partials)
+}
+
+// Map every element to a value of a Monoid and combine all of them, in parallel.
+// An empty list results in the identity value of the Monoid.
+func MapReduce_par[T, U any](mapfn func(value T) U, monoid Basics.Monoid[U], list []T) U {
+	return Foldl_par(func(value T, acc U) U {
+		return monoid.Combine(acc, mapfn(value))
+	}, monoid, list)
+}
+
+// Get the sum of the list elements, in parallel.
+func Sum_par[T Basics.Number](list []T) Maybe[T] {
+	if len(list) == 0 {
+		return Nothing[T]()
+	}
+	return Just(Foldl_par(Basics.Add[T], Basics.SumMonoid[T](), list))
+}
+
+// Get the product of the list elements, in parallel.
+func Product_par[T Basics.Number](list []T) Maybe[T] {
+	if len(list) == 0 {
+		return Nothing[T]()
+	}
+	return Just(Foldl_par(Basics.Mul[T], Basics.ProductMonoid[T](), list))
+}
+
+// Find the maximum element in a non-empty list, in parallel.
+func Maximum_par[T Basics.Ordered](list []T) Maybe[T] {
+	if len(list) == 0 {
+		return Nothing[T]()
+	}
+	return Maximum(reduceChunks_par(func(chunk []T) T {
+		return Basics.Max(chunk[0], chunk[1:]...)
+	}, list))
+}
+
+// Find the minimum element in a non-empty list, in parallel.
+func Minimum_par[T Basics.Ordered](list []T) Maybe[T] {
+	if len(list) == 0 {
+		return Nothing[T]()
+	}
+	return Minimum(reduceChunks_par(func(chunk []T) T {
+		return Basics.Min(chunk[0], chunk[1:]...)
+	}, list))
+}
+
 // COMBINE
 
 // Map a given function onto a list and flatten the resulting lists.
@@ -184,6 +297,90 @@ func Map5_par[a, b, c, d, e, result any](mapfn func(a a, b b, c c, d d, e e) res
 	return new_list
 }
 
+// SORT
+
+// Below this many elements the parallel sorts hand the work to the sequential sorts.
+const parSortThreshold = 1 << 14
+
+// Sort data in parallel by sorting both halves concurrently and merging them through buf.
+// depth limits how many times the work is split, below it or below parSortThreshold
+// the sequential sortfn is used.
+// The merge takes from the left half on ties, so the sort is stable if sortfn is stable.
+func parMergeSort[E any](data, buf []E, depth int, sortfn func(data []E), lessfn func(a, b E) bool) {
+	if len(data) <= parSortThreshold || depth <= 0 {
+		sortfn(data)
+		return
+	}
+	mid := len(data) / 2
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		parMergeSort(data[:mid], buf[:mid], depth-1, sortfn, lessfn)
+	}()
+	parMergeSort(data[mid:], buf[mid:], depth-1, sortfn, lessfn)
+	wg.Wait()
+	// The halves are already in order, no need to merge.
+	if !lessfn(data[mid], data[mid-1]) {
+		return
+	}
+	mergeInto(buf, data[:mid], data[mid:], lessfn)
+	copy(data, buf)
+}
+
+// Sort a copy of the list in parallel, splitting it into about one part per CPU.
+func parSort[E any](list []E, sortfn func(data []E), lessfn func(a, b E) bool) []E {
+	new_list := clone(list)
+	depth := bits.Len(uint(runtime.GOMAXPROCS(0) - 1))
+	parMergeSort(new_list, make([]E, len(new_list)), depth, sortfn, lessfn)
+	return new_list
+}
+
+// Sort values from lowest to highest, in parallel.
+// Lists shorter than a threshold are sorted sequentially, exactly like `Sort`.
+// This functions is IMMUTABLE and produces a completely new list!
+func Sort_par[T Basics.Ordered](list []T) []T {
+	return parSort(list, func(data []T) {
+		pdqsortOrdered(data, 0, len(data), bits.Len(uint(len(data))))
+	}, Basics.Lt[T])
+}
+
+// Sort values by a derived property, in parallel.
+// Lists shorter than a threshold are sorted sequentially, exactly like `SortBy`.
+// The sort is NOT stable, use `SortStableBy_par` to keep the order of equal elements.
+// This functions is IMMUTABLE and produces a completely new list!
+func SortBy_par[T any, U Basics.Ordered](mapfn func(value T) U, list []T) []T {
+	return SortWith_par(Basics.Comparing[T](mapfn), list)
+}
+
+// Sort values with a custom comparison function, in parallel.
+// Lists shorter than a threshold are sorted sequentially, exactly like `SortWith`.
+// The sort is NOT stable, use `SortStableWith_par` to keep the order of equal elements.
+// This functions is IMMUTABLE and produces a completely new list!
+func SortWith_par[T any](cmpfn func(a, b T) Basics.Order, list []T) []T {
+	return parSort(list, func(data []T) {
+		pdqsortCmpFunc(data, 0, len(data), bits.Len(uint(len(data))), cmpfn)
+	}, func(a, b T) bool {
+		return cmpfn(a, b) < 0
+	})
+}
+
+// Sort values by a derived property, in parallel, keeping equal elements in their original order.
+// This functions is IMMUTABLE and produces a completely new list!
+func SortStableBy_par[T any, U Basics.Ordered](mapfn func(value T) U, list []T) []T {
+	return SortStableWith_par(Basics.Comparing[T](mapfn), list)
+}
+
+// Sort values with a custom comparison function, in parallel, keeping equal elements in their original order.
+// This functions is IMMUTABLE and produces a completely new list!
+func SortStableWith_par[T any](cmpfn func(a, b T) Basics.Order, list []T) []T {
+	return parSort(list, func(data []T) {
+		stableCmpFunc(data, len(data), cmpfn)
+	}, func(a, b T) bool {
+		return cmpfn(a, b) < 0
+	})
+}
+
 // Partition a list based on some test. The first list contains all values that satisfy the test, and the second list contains all the value that do not.
 func Partition_par[T any](testfn func(value T) bool, list []T) ([]T, []T) {
 	length := len(list)
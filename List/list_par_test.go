@@ -0,0 +1,148 @@
+package List_test
+
+import (
+	"math/rand"
+	"runtime"
+	"sort"
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	"github.com/manwitha1000names/gofp/v3/List"
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
+// Large enough to be split over several goroutines.
+const parSize = 100_000
+
+func TestSumAndProduct(t *testing.T) {
+	list := List.Range(1, parSize)
+	assertEqual(t, "Sum_par", List.Sum_par(list), MaybeResult.Just(parSize*(parSize+1)/2))
+	assertEqual(t, "Sum_par of an empty list", List.Sum_par([]int{}), MaybeResult.Nothing[int]())
+
+	assertEqual(t, "Product", List.Product([]int{2, 3, 4}), MaybeResult.Just(24))
+	assertEqual(t, "Product of a single element", List.Product([]int{7}), MaybeResult.Just(7))
+	assertEqual(t, "Product of an empty list", List.Product([]int{}), MaybeResult.Nothing[int]())
+
+	ones := List.Repeat(parSize, 1)
+	ones[parSize/2] = 3
+	assertEqual(t, "Product_par", List.Product_par(ones), MaybeResult.Just(3))
+	assertEqual(t, "Product_par of an empty list", List.Product_par([]int{}), MaybeResult.Nothing[int]())
+}
+
+func TestMaximumMinimum_par(t *testing.T) {
+	list := List.Range(1, parSize)
+	list[parSize/3], list[parSize/2] = -5, parSize*2
+	assertEqual(t, "Maximum_par", List.Maximum_par(list), MaybeResult.Just(parSize*2))
+	assertEqual(t, "Minimum_par", List.Minimum_par(list), MaybeResult.Just(-5))
+	assertEqual(t, "Maximum_par of an empty list", List.Maximum_par([]int{}), MaybeResult.Nothing[int]())
+	assertEqual(t, "Minimum_par of an empty list", List.Minimum_par([]int{}), MaybeResult.Nothing[int]())
+}
+
+// A run of integers, combining two runs is only ascending when the first ends before the second starts.
+// This Monoid is associative but not commutative, so any reordering of the chunks shows up.
+type run struct {
+	empty     bool
+	first     int
+	last      int
+	ascending bool
+}
+
+var ascendingMonoid = Basics.NewMonoid(run{empty: true, ascending: true}, func(a, b run) run {
+	if a.empty {
+		return b
+	}
+	if b.empty {
+		return a
+	}
+	return run{first: a.first, last: b.last, ascending: a.ascending && b.ascending && a.last < b.first}
+})
+
+func TestFoldl_parKeepsTheOrder(t *testing.T) {
+	single := func(n int, acc run) run {
+		return ascendingMonoid.Combine(acc, run{first: n, last: n, ascending: true})
+	}
+	assertEqual(t, "Foldl_par", List.Foldl_par(single, ascendingMonoid, List.Range(1, parSize)), run{first: 1, last: parSize, ascending: true})
+	assertEqual(t, "Foldl_par of a descending list", List.Foldl_par(single, ascendingMonoid, List.Map(func(n int) int { return parSize - n }, List.Range(1, parSize))).ascending, false)
+	assertEqual(t, "Foldl_par of an empty list", List.Foldl_par(single, ascendingMonoid, []int{}), ascendingMonoid.Empty())
+}
+
+func TestMapReduce_par(t *testing.T) {
+	list := List.Range(1, parSize)
+	isEven := func(n int) bool { return n%2 == 0 }
+	assertEqual(t, "MapReduce_par", List.MapReduce_par(func(n int) int { return n % 7 }, Basics.SumMonoid[int](), list),
+		List.MapReduce(func(n int) int { return n % 7 }, Basics.SumMonoid[int](), list))
+	assertEqual(t, "MapReduce_par with AnyMonoid", List.MapReduce_par(isEven, Basics.AnyMonoid(), list), true)
+	assertEqual(t, "MapReduce_par with AllMonoid", List.MapReduce_par(isEven, Basics.AllMonoid(), list), false)
+	assertEqual(t, "MapReduce_par of an empty list", List.MapReduce_par(isEven, Basics.AllMonoid(), []int{}), true)
+}
+
+// Use several CPUs, so the parallel sorts really split the work even on a single core machine.
+func withProcs(t *testing.T, n int) {
+	previous := runtime.GOMAXPROCS(n)
+	t.Cleanup(func() { runtime.GOMAXPROCS(previous) })
+}
+
+func randomInts(seed int64, n int, max int) []int {
+	random := rand.New(rand.NewSource(seed))
+	list := make([]int, n)
+	for i := range list {
+		list[i] = random.Intn(max)
+	}
+	return list
+}
+
+func TestSort_par(t *testing.T) {
+	withProcs(t, 4)
+	for _, n := range []int{0, 1, 100, parSize} {
+		list := randomInts(int64(n), n, n/4+1)
+		original := copyInts(list)
+		want := copyInts(list)
+		sort.Ints(want)
+		assertEqual(t, "Sort_par", List.Sort_par(list), want)
+		assertEqual(t, "the list after Sort_par", list, original)
+	}
+	sorted := List.Range(1, parSize)
+	assertEqual(t, "Sort_par of a sorted list", List.Sort_par(sorted), List.Range(1, parSize))
+	descending := List.Map(func(n int) int { return parSize - n }, List.Range(1, parSize))
+	assertEqual(t, "Sort_par of a descending list", List.Sort_par(descending), List.Range(0, parSize-1))
+}
+
+func TestSortByWith_par(t *testing.T) {
+	withProcs(t, 4)
+	list := randomInts(1, parSize, parSize)
+	want := copyInts(list)
+	sort.Sort(sort.Reverse(sort.IntSlice(want)))
+	assertEqual(t, "SortBy_par", List.SortBy_par(Basics.Negate[int], list), want)
+	assertEqual(t, "SortWith_par", List.SortWith_par(Basics.Reversed(Basics.Compare[int]), list), want)
+}
+
+func copyInts(list []int) []int {
+	new_list := make([]int, len(list))
+	copy(new_list, list)
+	return new_list
+}
+
+func TestSortStable_par(t *testing.T) {
+	withProcs(t, 4)
+	// Few distinct keys, so there are many ties that have to keep their order across the parallel merges.
+	keys := randomInts(2, parSize, 10)
+	indexes := List.Range(0, parSize-1)
+	byKey := func(i int) int { return keys[i] }
+	got := List.SortStableBy_par(byKey, indexes)
+	for i := 1; i < len(got); i++ {
+		if keys[got[i-1]] > keys[got[i]] || (keys[got[i-1]] == keys[got[i]] && got[i-1] > got[i]) {
+			t.Fatalf("SortStableBy_par put %d before %d", got[i-1], got[i])
+		}
+	}
+	assertEqual(t, "SortStableWith_par", List.SortStableWith_par(Basics.Comparing(byKey), indexes), got)
+	assertEqual(t, "SortStableBy", List.SortStableBy(byKey, indexes), got)
+}
+
+func TestMapChunked_par(t *testing.T) {
+	list := List.Range(1, 1000)
+	square := func(n int) int { return n * n }
+	for _, size := range []int{-1, 1, 7, 1000, 5000} {
+		assertEqual(t, "MapChunked_par", List.MapChunked_par(size, square, list), List.Map(square, list))
+	}
+	assertEqual(t, "MapChunked_par of an empty list", len(List.MapChunked_par(3, square, []int{})), 0)
+}
@@ -0,0 +1,736 @@
+package List_test
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	"github.com/manwitha1000names/gofp/v3/Either"
+	"github.com/manwitha1000names/gofp/v3/List"
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Tuple"
+)
+
+// Fail the test when got and want are not deeply equal.
+func assertEqual[T any](t *testing.T, name string, got, want T) {
+	t.Helper()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s = %v, want %v", name, got, want)
+	}
+}
+
+func TestEithers(t *testing.T) {
+	list := []Either.Either[string, int]{
+		Either.Right[string](1),
+		Either.Left[string, int]("a"),
+		Either.Right[string](2),
+		Either.Left[string, int]("b"),
+	}
+	assertEqual(t, "Lefts", List.Lefts(list), []string{"a", "b"})
+	assertEqual(t, "Rights", List.Rights(list), []int{1, 2})
+	lefts, rights := List.PartitionEithers(list)
+	assertEqual(t, "PartitionEithers lefts", lefts, []string{"a", "b"})
+	assertEqual(t, "PartitionEithers rights", rights, []int{1, 2})
+
+	lefts, rights = List.PartitionEithers([]Either.Either[string, int]{})
+	assertEqual(t, "PartitionEithers empty lefts", lefts, []string{})
+	assertEqual(t, "PartitionEithers empty rights", rights, []int{})
+}
+
+type person struct {
+	name string
+	age  int
+}
+
+func TestSortStable(t *testing.T) {
+	list := []int{5, 3, 5, 1, 3}
+	assertEqual(t, "SortStable", List.SortStable(list), []int{1, 3, 3, 5, 5})
+	assertEqual(t, "the list after SortStable", list, []int{5, 3, 5, 1, 3})
+	assertEqual(t, "SortStable of an empty list", List.SortStable([]int{}), []int{})
+
+	people := []person{{"ann", 30}, {"bob", 25}, {"cid", 30}, {"dan", 25}, {"eve", 20}}
+	byAge := List.SortStableBy(func(p person) int { return p.age }, people)
+	assertEqual(t, "SortStableBy", byAge, []person{{"eve", 20}, {"bob", 25}, {"dan", 25}, {"ann", 30}, {"cid", 30}})
+
+	// Sorting by the second key first, then stable sorting by the first key, orders by both keys.
+	byName := List.SortStableWith(Basics.Reversed(Basics.Comparing(func(p person) string { return p.name })), people)
+	byAgeThenName := List.SortStableBy(func(p person) int { return p.age }, byName)
+	assertEqual(t, "SortStableBy after sorting by name", byAgeThenName, []person{{"eve", 20}, {"dan", 25}, {"bob", 25}, {"cid", 30}, {"ann", 30}})
+	assertEqual(t, "SortWith with ThenComparing", List.SortWith(Basics.ThenComparing(
+		Basics.Comparing(func(p person) int { return p.age }),
+		Basics.Reversed(Basics.Comparing(func(p person) string { return p.name })),
+	), people), byAgeThenName)
+}
+
+func TestBounds(t *testing.T) {
+	list := []int{1, 3, 3, 3, 5}
+	cases := []struct {
+		value, lower, upper int
+	}{
+		{0, 0, 0},
+		{1, 0, 1},
+		{2, 1, 1},
+		{3, 1, 4},
+		{5, 4, 5},
+		{6, 5, 5},
+	}
+	for _, c := range cases {
+		assertEqual(t, "LowerBound", List.LowerBound(c.value, list), c.lower)
+		assertEqual(t, "UpperBound", List.UpperBound(c.value, list), c.upper)
+		start, end := List.EqualRange(c.value, list)
+		assertEqual(t, "EqualRange", [2]int{start, end}, [2]int{c.lower, c.upper})
+	}
+	assertEqual(t, "LowerBound of an empty list", List.LowerBound(1, []int{}), 0)
+	assertEqual(t, "UpperBound of an empty list", List.UpperBound(1, []int{}), 0)
+
+	descending := []int{5, 3, 3, 1}
+	reversed := Basics.Reversed(Basics.Compare[int])
+	assertEqual(t, "LowerBoundWith", List.LowerBoundWith(reversed, 3, descending), 1)
+	assertEqual(t, "UpperBoundWith", List.UpperBoundWith(reversed, 3, descending), 3)
+	start, end := List.EqualRangeWith(reversed, 4, descending)
+	assertEqual(t, "EqualRangeWith of a missing value", [2]int{start, end}, [2]int{1, 1})
+}
+
+func TestBinarySearch(t *testing.T) {
+	list := []int{1, 3, 3, 3, 5}
+	assertEqual(t, "BinarySearch finds the first index", List.BinarySearch(3, list), MaybeResult.Just(1))
+	assertEqual(t, "BinarySearch of the last element", List.BinarySearch(5, list), MaybeResult.Just(4))
+	assertEqual(t, "BinarySearch of a missing value", List.BinarySearch(4, list), MaybeResult.Nothing[int]())
+	assertEqual(t, "BinarySearch past the end", List.BinarySearch(9, list), MaybeResult.Nothing[int]())
+	assertEqual(t, "BinarySearch of an empty list", List.BinarySearch(1, []int{}), MaybeResult.Nothing[int]())
+
+	people := []person{{"eve", 20}, {"bob", 25}, {"dan", 25}, {"ann", 30}}
+	age := func(p person) int { return p.age }
+	assertEqual(t, "BinarySearchBy", List.BinarySearchBy(age, 25, people), MaybeResult.Just(1))
+	assertEqual(t, "BinarySearchBy of a missing key", List.BinarySearchBy(age, 26, people), MaybeResult.Nothing[int]())
+	assertEqual(t, "BinarySearchWith", List.BinarySearchWith(Basics.Comparing(age), person{"", 30}, people), MaybeResult.Just(3))
+}
+
+func TestBinarySearchAgreesWithALinearScan(t *testing.T) {
+	random := rand.New(rand.NewSource(3))
+	for round := 0; round < 200; round++ {
+		list := make([]int, random.Intn(30))
+		for i := range list {
+			list[i] = random.Intn(10)
+		}
+		list = List.Sort(list)
+		value := random.Intn(12) - 1
+		lower, upper := 0, 0
+		for _, element := range list {
+			if element < value {
+				lower++
+			}
+			if element <= value {
+				upper++
+			}
+		}
+		assertEqual(t, "LowerBound", List.LowerBound(value, list), lower)
+		assertEqual(t, "UpperBound", List.UpperBound(value, list), upper)
+		want := MaybeResult.Nothing[int]()
+		if lower < upper {
+			want = MaybeResult.Just(lower)
+		}
+		assertEqual(t, "BinarySearch", List.BinarySearch(value, list), want)
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	list := []int{1, 3, 5}
+	assertEqual(t, "InsertSorted in the middle", List.InsertSorted(4, list), []int{1, 3, 4, 5})
+	assertEqual(t, "InsertSorted at the front", List.InsertSorted(0, list), []int{0, 1, 3, 5})
+	assertEqual(t, "InsertSorted at the back", List.InsertSorted(9, list), []int{1, 3, 5, 9})
+	assertEqual(t, "InsertSorted into an empty list", List.InsertSorted(1, []int{}), []int{1})
+	assertEqual(t, "the list after InsertSorted", list, []int{1, 3, 5})
+
+	people := []person{{"bob", 25}, {"ann", 30}}
+	assertEqual(t, "InsertSortedWith goes after equal elements",
+		List.InsertSortedWith(Basics.Comparing(func(p person) int { return p.age }), person{"cid", 25}, people),
+		[]person{{"bob", 25}, {"cid", 25}, {"ann", 30}})
+}
+
+func TestSortedSetOperations(t *testing.T) {
+	a := []int{1, 2, 2, 2, 4, 6}
+	b := []int{2, 2, 3, 4, 4, 7}
+	assertEqual(t, "MergeSorted", List.MergeSorted(a, b), []int{1, 2, 2, 2, 2, 2, 3, 4, 4, 4, 6, 7})
+	assertEqual(t, "SortedUnion", List.SortedUnion(a, b), []int{1, 2, 2, 2, 3, 4, 4, 6, 7})
+	assertEqual(t, "SortedIntersect", List.SortedIntersect(a, b), []int{2, 2, 4})
+	assertEqual(t, "SortedDiff", List.SortedDiff(a, b), []int{1, 2, 6})
+	assertEqual(t, "SortedDiff the other way", List.SortedDiff(b, a), []int{3, 4, 7})
+
+	empty := []int{}
+	assertEqual(t, "MergeSorted with an empty list", List.MergeSorted(a, empty), a)
+	assertEqual(t, "SortedUnion with an empty list", List.SortedUnion(empty, b), b)
+	assertEqual(t, "SortedIntersect with an empty list", List.SortedIntersect(a, empty), []int{})
+	assertEqual(t, "SortedDiff with an empty list", List.SortedDiff(a, empty), a)
+
+	// The With variants keep the elements of the first list on ties.
+	byAge := Basics.Comparing(func(p person) int { return p.age })
+	older := []person{{"ann", 25}, {"bob", 30}}
+	younger := []person{{"cid", 20}, {"dan", 25}}
+	assertEqual(t, "MergeSortedWith", List.MergeSortedWith(byAge, older, younger), []person{{"cid", 20}, {"ann", 25}, {"dan", 25}, {"bob", 30}})
+	assertEqual(t, "SortedUnionWith", List.SortedUnionWith(byAge, older, younger), []person{{"cid", 20}, {"ann", 25}, {"bob", 30}})
+	assertEqual(t, "SortedIntersectWith", List.SortedIntersectWith(byAge, older, younger), []person{{"ann", 25}})
+	assertEqual(t, "SortedDiffWith", List.SortedDiffWith(byAge, older, younger), []person{{"bob", 30}})
+}
+
+func TestNthElement(t *testing.T) {
+	list := []int{9, 1, 8, 2, 7, 3, 6, 4, 5, 5}
+	sorted := List.Sort(list)
+	for n := range list {
+		assertEqual(t, "NthElement", List.NthElement(n, list), MaybeResult.Just(sorted[n]))
+	}
+	assertEqual(t, "NthElement below the range", List.NthElement(-1, list), MaybeResult.Nothing[int]())
+	assertEqual(t, "NthElement above the range", List.NthElement(len(list), list), MaybeResult.Nothing[int]())
+	assertEqual(t, "NthElement of an empty list", List.NthElement(0, []int{}), MaybeResult.Nothing[int]())
+	assertEqual(t, "the list after NthElement", list, []int{9, 1, 8, 2, 7, 3, 6, 4, 5, 5})
+	assertEqual(t, "NthElementBy", List.NthElementBy(Basics.Negate[int], 0, list), MaybeResult.Just(9))
+
+	// Large random lists go through the quickselect rather than the insertion sort.
+	random := rand.New(rand.NewSource(4))
+	large := make([]int, 5000)
+	for i := range large {
+		large[i] = random.Intn(1000)
+	}
+	sorted = List.Sort(large)
+	for _, n := range []int{0, 1, 2500, 4998, 4999} {
+		assertEqual(t, "NthElement of a large list", List.NthElement(n, large), MaybeResult.Just(sorted[n]))
+	}
+}
+
+// Build a comparison function that makes every pivot a bad one, after "A Killer Adversary for Quicksort" by M. D. McIlroy.
+// Elements are indexes into the values, which are decided lazily while comparing:
+// all undecided values are larger than every decided one, and the pivot candidate is decided to be the smallest.
+// The final order is the one of the values once they are all decided.
+func killerAdversary(n int) (cmpfn func(a, b int) Basics.Order, values []int, comparisons *int) {
+	values = make([]int, n)
+	gas := n
+	for i := range values {
+		values[i] = gas
+	}
+	solid, candidate, count := 0, -1, 0
+	return func(x, y int) Basics.Order {
+		count++
+		if values[x] == gas && values[y] == gas {
+			if x == candidate {
+				values[x] = solid
+			} else {
+				values[y] = solid
+			}
+			solid++
+		}
+		if values[x] == gas {
+			candidate = x
+		} else if values[y] == gas {
+			candidate = y
+		}
+		return Basics.Compare(values[x], values[y])
+	}, values, &count
+}
+
+func TestNthElementFallsBackToHeapsort(t *testing.T) {
+	const n = 2000
+	cmpfn, values, comparisons := killerAdversary(n)
+	list := List.NthElementWith_mut(cmpfn, n/2, List.Range(0, n-1))
+	for i, index := range list {
+		if (i < n/2 && values[index] > values[list[n/2]]) || (i > n/2 && values[index] < values[list[n/2]]) {
+			t.Fatalf("the element at %d is on the wrong side of the nth element", i)
+		}
+	}
+	// A quickselect that keeps picking bad pivots would take about n*n/2 comparisons.
+	if *comparisons > n*n/8 {
+		t.Errorf("NthElementWith_mut took %d comparisons, the heapsort fallback should keep it O(n log n)", *comparisons)
+	}
+}
+
+func TestTopKBottomK(t *testing.T) {
+	list := []int{5, 1, 9, 3, 7, 9, 2}
+	assertEqual(t, "TopK", List.TopK(3, list), []int{9, 9, 7})
+	assertEqual(t, "BottomK", List.BottomK(3, list), []int{1, 2, 3})
+	assertEqual(t, "TopK of zero elements", List.TopK(0, list), []int{})
+	assertEqual(t, "TopK of a negative amount", List.TopK(-2, list), []int{})
+	assertEqual(t, "TopK of more than the list", List.TopK(10, list), []int{9, 9, 7, 5, 3, 2, 1})
+	assertEqual(t, "BottomK of an empty list", List.BottomK(3, []int{}), []int{})
+	assertEqual(t, "the list after TopK", list, []int{5, 1, 9, 3, 7, 9, 2})
+
+	people := []person{{"ann", 30}, {"bob", 25}, {"cid", 40}, {"dan", 20}}
+	age := func(p person) int { return p.age }
+	assertEqual(t, "TopKBy", List.TopKBy(age, 2, people), []person{{"cid", 40}, {"ann", 30}})
+	assertEqual(t, "BottomKBy", List.BottomKBy(age, 2, people), []person{{"dan", 20}, {"bob", 25}})
+	assertEqual(t, "TopKWith", List.TopKWith(Basics.Comparing(age), 1, people), []person{{"cid", 40}})
+	assertEqual(t, "BottomKWith", List.BottomKWith(Basics.Comparing(age), 1, people), []person{{"dan", 20}})
+}
+
+func TestPartialSort(t *testing.T) {
+	random := rand.New(rand.NewSource(5))
+	list := make([]int, 1000)
+	for i := range list {
+		list[i] = random.Intn(100)
+	}
+	sorted := List.Sort(list)
+	for _, k := range []int{0, 1, 10, 999, 1000} {
+		got := List.PartialSort(k, list)
+		assertEqual(t, "the sorted front of PartialSort", got[:k], sorted[:k])
+		assertEqual(t, "all the elements of PartialSort", List.Sort(got), sorted)
+	}
+	assertEqual(t, "PartialSort of more than the list", List.PartialSort(5, []int{3, 1, 2}), []int{1, 2, 3})
+	assertEqual(t, "PartialSortBy", List.PartialSortBy(Basics.Negate[int], 2, []int{3, 1, 2})[:2], []int{3, 2})
+}
+
+func TestMedianQuantile(t *testing.T) {
+	assertEqual(t, "Median of an odd amount", List.Median([]int{3, 1, 2}), MaybeResult.Just(2.0))
+	assertEqual(t, "Median of an even amount", List.Median([]int{4, 1, 3, 2}), MaybeResult.Just(2.5))
+	assertEqual(t, "Median of a single element", List.Median([]float64{7}), MaybeResult.Just(7.0))
+	assertEqual(t, "Median of an empty list", List.Median([]int{}), MaybeResult.Nothing[float64]())
+
+	list := []int{10, 40, 20, 30, 50}
+	assertEqual(t, "Quantile 0", List.Quantile(0, list), MaybeResult.Just(10.0))
+	assertEqual(t, "Quantile 1", List.Quantile(1, list), MaybeResult.Just(50.0))
+	assertEqual(t, "Quantile 0.25", List.Quantile(0.25, list), MaybeResult.Just(20.0))
+	assertEqual(t, "Quantile 0.1 is interpolated", List.Quantile(0.1, list), MaybeResult.Just(14.0))
+	assertEqual(t, "Quantile below 0", List.Quantile(-0.1, list), MaybeResult.Nothing[float64]())
+	assertEqual(t, "Quantile above 1", List.Quantile(1.1, list), MaybeResult.Nothing[float64]())
+	assertEqual(t, "Quantile NaN", List.Quantile(math.NaN(), list), MaybeResult.Nothing[float64]())
+	assertEqual(t, "the list after Quantile", list, []int{10, 40, 20, 30, 50})
+}
+
+func TestGenerators(t *testing.T) {
+	halve := func(n int) MaybeResult.Maybe[int] {
+		if n <= 1 {
+			return MaybeResult.Nothing[int]()
+		}
+		return MaybeResult.Just(n / 2)
+	}
+	assertEqual(t, "Iterate", List.Iterate(halve, 20), []int{20, 10, 5, 2, 1})
+	assertEqual(t, "Iterate stopping at once", List.Iterate(halve, 1), []int{1})
+
+	digits := func(n int) MaybeResult.Maybe[Tuple.Tuple[int, int]] {
+		if n == 0 {
+			return MaybeResult.Nothing[Tuple.Tuple[int, int]]()
+		}
+		return MaybeResult.Just(Tuple.Pair(n%10, n/10))
+	}
+	assertEqual(t, "Unfoldr", List.Unfoldr(digits, 1234), []int{4, 3, 2, 1})
+	assertEqual(t, "Unfoldr stopping at once", len(List.Unfoldr(digits, 0)), 0)
+
+	assertEqual(t, "Cycle", List.Cycle(7, []int{1, 2, 3}), []int{1, 2, 3, 1, 2, 3, 1})
+	assertEqual(t, "Cycle shorter than the list", List.Cycle(2, []int{1, 2, 3}), []int{1, 2})
+	assertEqual(t, "Cycle of an empty list", len(List.Cycle(5, []int{})), 0)
+	assertEqual(t, "Cycle of a negative length", len(List.Cycle(-1, []int{1})), 0)
+}
+
+func TestScans(t *testing.T) {
+	assertEqual(t, "Scanl", List.Scanl(Basics.Add[int], 0, []int{1, 2, 3}), []int{0, 1, 3, 6})
+	assertEqual(t, "Scanl of an empty list", List.Scanl(Basics.Add[int], 5, []int{}), []int{5})
+	subtract := func(value int, acc int) int { return value - acc }
+	// 1 - (2 - (3 - 0)), 2 - (3 - 0), 3 - 0, 0
+	assertEqual(t, "Scanr", List.Scanr(subtract, 0, []int{1, 2, 3}), []int{2, -1, 3, 0})
+	assertEqual(t, "Scanr of an empty list", List.Scanr(subtract, 5, []int{}), []int{5})
+	assertEqual(t, "the last of Scanl is Foldl", List.Last(List.Scanl(Basics.Add[int], 0, []int{4, 5})), MaybeResult.Just(List.Foldl(Basics.Add[int], 0, []int{4, 5})))
+}
+
+func TestUniqueAndRemove(t *testing.T) {
+	list := []int{3, 1, 3, 2, 1}
+	assertEqual(t, "Unique", List.Unique(list), []int{3, 1, 2})
+	assertEqual(t, "UniqueBy", List.UniqueBy(func(n int) int { return n % 2 }, list), []int{3, 2})
+	assertEqual(t, "Remove takes the first occurrence", List.Remove(3, list), []int{1, 3, 2, 1})
+	assertEqual(t, "Remove of a missing value", List.Remove(9, list), list)
+	assertEqual(t, "RemoveAt", List.RemoveAt(1, list), []int{3, 3, 2, 1})
+	assertEqual(t, "RemoveAt out of range", List.RemoveAt(5, list), list)
+	assertEqual(t, "RemoveAt of a negative index", List.RemoveAt(-1, list), list)
+	assertEqual(t, "the list after removing", list, []int{3, 1, 3, 2, 1})
+}
+
+func TestInsertAndUpdate(t *testing.T) {
+	list := []int{1, 2, 3}
+	double := func(n int) int { return n * 2 }
+	assertEqual(t, "InsertAt the front", List.InsertAt(0, 0, list), []int{0, 1, 2, 3})
+	assertEqual(t, "InsertAt the middle", List.InsertAt(2, 9, list), []int{1, 2, 9, 3})
+	assertEqual(t, "InsertAt the end", List.InsertAt(3, 4, list), []int{1, 2, 3, 4})
+	assertEqual(t, "InsertAt out of range", List.InsertAt(4, 4, list), list)
+	assertEqual(t, "InsertAt a negative index", List.InsertAt(-1, 4, list), list)
+	assertEqual(t, "Update", List.Update(func(n int) bool { return n != 2 }, double, list), []int{2, 2, 6})
+	assertEqual(t, "UpdateAt", List.UpdateAt(1, double, list), []int{1, 4, 3})
+	assertEqual(t, "UpdateAt out of range", List.UpdateAt(3, double, list), list)
+	assertEqual(t, "the list after updating", list, []int{1, 2, 3})
+}
+
+func TestZipTranspose(t *testing.T) {
+	assertEqual(t, "Zip", List.Zip([]int{1, 2, 3}, []string{"a", "b"}), []Tuple.Tuple[int, string]{Tuple.Pair(1, "a"), Tuple.Pair(2, "b")})
+	assertEqual(t, "Zip with an empty list", len(List.Zip([]int{}, []string{"a"})), 0)
+	assertEqual(t, "Transpose", List.Transpose([][]int{{1, 2, 3}, {4, 5, 6}}), [][]int{{1, 4}, {2, 5}, {3, 6}})
+	assertEqual(t, "Transpose of ragged rows", List.Transpose([][]int{{1, 2, 3}, {4}, {5, 6}}), [][]int{{1, 4, 5}})
+	assertEqual(t, "Transpose of no rows", len(List.Transpose([][]int{})), 0)
+}
+
+func TestZipUnzipN(t *testing.T) {
+	ints, strs, bools, floats, runes := []int{1, 2, 3}, []string{"a", "b"}, []bool{true, false, true}, []float64{0.5, 1.5}, []rune{'x', 'y'}
+	assertEqual(t, "Zip3", List.Zip3(ints, strs, bools), []Tuple.Tuple3[int, string, bool]{Tuple.Triple(1, "a", true), Tuple.Triple(2, "b", false)})
+	assertEqual(t, "Zip4", List.Zip4(ints, strs, bools, floats), []Tuple.Tuple4[int, string, bool, float64]{
+		Tuple.Quadruple(1, "a", true, 0.5), Tuple.Quadruple(2, "b", false, 1.5),
+	})
+	assertEqual(t, "Zip5", List.Zip5(ints, strs, bools, floats, runes), []Tuple.Tuple5[int, string, bool, float64, rune]{
+		Tuple.Quintuple(1, "a", true, 0.5, 'x'), Tuple.Quintuple(2, "b", false, 1.5, 'y'),
+	})
+	assertEqual(t, "Zip3 with an empty list", len(List.Zip3(ints, []string{}, bools)), 0)
+
+	assertEqual(t, "Unzip3 of Zip3", List.Unzip3(List.Zip3(ints, []string{"a", "b", "c"}, bools)), Tuple.Triple(ints, []string{"a", "b", "c"}, bools))
+	assertEqual(t, "Unzip4 of Zip4", List.Unzip4(List.Zip4(strs, floats, runes, []int{7, 8})), Tuple.Quadruple(strs, floats, runes, []int{7, 8}))
+	assertEqual(t, "Unzip5 of Zip5", List.Unzip5(List.Zip5(strs, floats, runes, []int{7, 8}, []bool{true, true})), Tuple.Quintuple(strs, floats, runes, []int{7, 8}, []bool{true, true}))
+	assertEqual(t, "Unzip3 of an empty list", List.Unzip3([]Tuple.Tuple3[int, string, bool]{}), Tuple.Triple([]int{}, []string{}, []bool{}))
+
+	assertEqual(t, "CartesianProduct3", List.CartesianProduct3([]int{1, 2}, []string{"a"}, []bool{true, false}), []Tuple.Tuple3[int, string, bool]{
+		Tuple.Triple(1, "a", true), Tuple.Triple(1, "a", false), Tuple.Triple(2, "a", true), Tuple.Triple(2, "a", false),
+	})
+	assertEqual(t, "CartesianProduct3 with an empty list", len(List.CartesianProduct3([]int{1, 2}, []string{}, []bool{true})), 0)
+}
+
+func TestDeconstruction(t *testing.T) {
+	list := []int{1, 2, 3}
+	assertEqual(t, "Last", List.Last(list), MaybeResult.Just(3))
+	assertEqual(t, "Last of an empty list", List.Last([]int{}), MaybeResult.Nothing[int]())
+	assertEqual(t, "Init", List.Init(list), MaybeResult.Just([]int{1, 2}))
+	assertEqual(t, "Init of an empty list", List.Init([]int{}), MaybeResult.Nothing[[]int]())
+	assertEqual(t, "Uncons", List.Uncons(list), MaybeResult.Just(Tuple.Pair(1, []int{2, 3})))
+	assertEqual(t, "Uncons of an empty list", List.Uncons([]int{}), MaybeResult.Nothing[Tuple.Tuple[int, []int]]())
+
+	small := func(n int) bool { return n < 3 }
+	mixed := []int{1, 2, 3, 1}
+	assertEqual(t, "TakeWhile", List.TakeWhile(small, mixed), []int{1, 2})
+	assertEqual(t, "DropWhile", List.DropWhile(small, mixed), []int{3, 1})
+	assertEqual(t, "TakeWhile of all", List.TakeWhile(small, []int{1, 2}), []int{1, 2})
+	assertEqual(t, "DropWhile of all", len(List.DropWhile(small, []int{1, 2})), 0)
+	before, after := List.Span(small, mixed)
+	assertEqual(t, "Span", [][]int{before, after}, [][]int{{1, 2}, {3, 1}})
+	for _, c := range []struct{ n, split int }{{-1, 0}, {0, 0}, {2, 2}, {4, 4}, {9, 4}} {
+		front, back := List.SplitAt(c.n, mixed)
+		if len(front) != c.split || !reflect.DeepEqual(append(append([]int{}, front...), back...), mixed) {
+			t.Errorf("SplitAt(%d) = %v, %v", c.n, front, back)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	list := []string{"a", "b", "a", "c"}
+	isA := func(s string) bool { return s == "a" }
+	assertEqual(t, "FindIndex", List.FindIndex(isA, list), MaybeResult.Just(0))
+	assertEqual(t, "FindIndex of nothing", List.FindIndex(isA, []string{"b"}), MaybeResult.Nothing[int]())
+	assertEqual(t, "ElemIndex", List.ElemIndex("c", list), MaybeResult.Just(3))
+	assertEqual(t, "ElemIndex of a missing value", List.ElemIndex("z", list), MaybeResult.Nothing[int]())
+	assertEqual(t, "Indices", List.Indices(isA, list), []int{0, 2})
+	assertEqual(t, "Indices of nothing", len(List.Indices(isA, []string{})), 0)
+
+	pairs := []Tuple.Tuple[string, int]{Tuple.Pair("x", 1), Tuple.Pair("y", 2), Tuple.Pair("x", 3)}
+	assertEqual(t, "Lookup takes the first pair", List.Lookup("x", pairs), MaybeResult.Just(1))
+	assertEqual(t, "Lookup of a missing key", List.Lookup("z", pairs), MaybeResult.Nothing[int]())
+}
+
+func TestGroupsAreCopies(t *testing.T) {
+	list := []int{1, 2, 3, 4, 5}
+	chunks := List.Chunk(2, list)
+	assertEqual(t, "Chunk", chunks, [][]int{{1, 2}, {3, 4}, {5}})
+	windows := List.Windows(2, 1, list)
+	assertEqual(t, "Windows", windows, [][]int{{1, 2}, {2, 3}, {3, 4}, {4, 5}})
+	groups := List.GroupWhile(func(previous, next int) bool { return next-previous == 1 }, list)
+	assertEqual(t, "GroupWhile", groups, [][]int{{1, 2, 3, 4, 5}})
+	byParity := List.GroupBy(func(n int) bool { return n%2 == 0 }, []int{1, 3, 2})
+	assertEqual(t, "GroupBy", byParity, [][]int{{1, 3}, {2}})
+	split := List.SplitWhen(func(n int) bool { return n == 3 }, list)
+	assertEqual(t, "SplitWhen", split, [][]int{{1, 2}, {3, 4, 5}})
+
+	chunks[0][0], windows[0][0], groups[0][1], split[1][0] = 10, 10, 20, 30
+	assertEqual(t, "the list after changing the copies", list, []int{1, 2, 3, 4, 5})
+}
+
+func TestPairwise(t *testing.T) {
+	assertEqual(t, "Pairwise", List.Pairwise([]int{1, 2, 3}), []Tuple.Tuple[int, int]{Tuple.Pair(1, 2), Tuple.Pair(2, 3)})
+	assertEqual(t, "Pairwise of a single element", len(List.Pairwise([]int{1})), 0)
+	assertEqual(t, "Pairwise of an empty list", len(List.Pairwise([]int{})), 0)
+}
+
+// Drain an iterator into a list.
+func collectAll[T any](next func() MaybeResult.Maybe[T]) []T {
+	list := []T{}
+	for value := next(); value.IsJust(); value = next() {
+		list = append(list, value.Expect())
+	}
+	return list
+}
+
+func TestPermutations(t *testing.T) {
+	assertEqual(t, "Permutations", List.Permutations([]int{1, 2, 3}), [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}})
+	assertEqual(t, "Permutations of an empty list", List.Permutations([]int{}), [][]int{{}})
+	// Positions are permuted, not values, so duplicates give duplicate permutations.
+	assertEqual(t, "Permutations of duplicates", List.Permutations([]int{1, 1}), [][]int{{1, 1}, {1, 1}})
+
+	list := []int{1, 2, 3, 4, 5}
+	all := List.Permutations(list)
+	assertEqual(t, "the amount of Permutations", len(all), 120)
+	assertEqual(t, "PermutationsIterator", collectAll(List.PermutationsIterator(list)), all)
+	assertEqual(t, "the distinct Permutations", len(List.UniqueBy(func(p []int) [5]int { return *(*[5]int)(p) }, all)), 120)
+	assertEqual(t, "the list after Permutations", list, []int{1, 2, 3, 4, 5})
+
+	// Every permutation is a new list, changing one does not change the next.
+	next := List.PermutationsIterator([]int{1, 2})
+	first := next().Expect()
+	first[0] = 9
+	assertEqual(t, "the permutation after changing the previous one", next().Expect(), []int{2, 1})
+}
+
+func TestCombinations(t *testing.T) {
+	list := []string{"a", "b", "c", "d"}
+	assertEqual(t, "Combinations", List.Combinations(2, list), [][]string{{"a", "b"}, {"a", "c"}, {"a", "d"}, {"b", "c"}, {"b", "d"}, {"c", "d"}})
+	assertEqual(t, "Combinations of zero elements", List.Combinations(0, list), [][]string{{}})
+	assertEqual(t, "Combinations of all elements", List.Combinations(4, list), [][]string{list})
+	assertEqual(t, "Combinations of too many elements", len(List.Combinations(5, list)), 0)
+	assertEqual(t, "Combinations of a negative amount", len(List.Combinations(-1, list)), 0)
+
+	large := List.Range(1, 10)
+	binomial := []int{1, 10, 45, 120, 210, 252, 210, 120, 45, 10, 1}
+	for k, want := range binomial {
+		all := List.Combinations(k, large)
+		assertEqual(t, "the amount of Combinations", len(all), want)
+		assertEqual(t, "CombinationsIterator", collectAll(List.CombinationsIterator(k, large)), all)
+	}
+}
+
+func TestSubsequences(t *testing.T) {
+	assertEqual(t, "Subsequences", List.Subsequences([]int{1, 2, 3}), [][]int{{}, {1}, {2}, {1, 2}, {3}, {1, 3}, {2, 3}, {1, 2, 3}})
+	assertEqual(t, "Subsequences of an empty list", List.Subsequences([]int{}), [][]int{{}})
+	list := List.Range(1, 8)
+	all := List.Subsequences(list)
+	assertEqual(t, "the amount of Subsequences", len(all), 256)
+	assertEqual(t, "SubsequencesIterator", collectAll(List.SubsequencesIterator(list)), all)
+}
+
+func TestCartesianProduct(t *testing.T) {
+	assertEqual(t, "CartesianProduct", List.CartesianProduct([]int{1, 2}, []string{"a", "b"}),
+		[]Tuple.Tuple[int, string]{Tuple.Pair(1, "a"), Tuple.Pair(1, "b"), Tuple.Pair(2, "a"), Tuple.Pair(2, "b")})
+	assertEqual(t, "CartesianProduct with an empty list", len(List.CartesianProduct([]int{1}, []string{})), 0)
+
+	lists := [][]int{{1, 2}, {3}, {4, 5}}
+	all := List.CartesianProductN(lists)
+	assertEqual(t, "CartesianProductN", all, [][]int{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}})
+	assertEqual(t, "CartesianProductNIterator", collectAll(List.CartesianProductNIterator(lists)), all)
+	assertEqual(t, "CartesianProductN of no lists", List.CartesianProductN([][]int{}), [][]int{{}})
+	assertEqual(t, "CartesianProductN with an empty list", len(List.CartesianProductN([][]int{{1}, {}})), 0)
+	assertEqual(t, "CartesianProductNIterator with an empty list", len(collectAll(List.CartesianProductNIterator([][]int{{1}, {}}))), 0)
+}
+
+func TestInterleave(t *testing.T) {
+	assertEqual(t, "Interleave", List.Interleave([][]int{{1, 4, 6}, {2}, {3, 5}}), []int{1, 2, 3, 4, 5, 6})
+	assertEqual(t, "Interleave of no lists", len(List.Interleave([][]int{})), 0)
+	assertEqual(t, "Interleave of empty lists", len(List.Interleave([][]int{{}, {}})), 0)
+}
+
+func TestListDiffIntersect(t *testing.T) {
+	a := []int{1, 2, 1, 3, 1}
+	b := []int{1, 1, 4}
+	assertEqual(t, "ListDiff removes the first occurrences", List.ListDiff(a, b), []int{2, 3, 1})
+	assertEqual(t, "ListIntersect keeps the first occurrences", List.ListIntersect(a, b), []int{1, 1})
+	assertEqual(t, "ListDiff the other way", List.ListDiff(b, a), []int{4})
+	assertEqual(t, "ListIntersect the other way", List.ListIntersect(b, a), []int{1, 1})
+	assertEqual(t, "ListDiff with an empty list", List.ListDiff(a, []int{}), a)
+	assertEqual(t, "ListIntersect with an empty list", len(List.ListIntersect(a, []int{})), 0)
+	assertEqual(t, "the lists after ListDiff", [][]int{a, b}, [][]int{{1, 2, 1, 3, 1}, {1, 1, 4}})
+}
+
+func TestExtremes(t *testing.T) {
+	list := []int{3, 9, 1, 9, 1}
+	assertEqual(t, "MaximumIndex takes the first", List.MaximumIndex(list), MaybeResult.Just(1))
+	assertEqual(t, "MinimumIndex takes the first", List.MinimumIndex(list), MaybeResult.Just(2))
+	assertEqual(t, "MaximumIndices", List.MaximumIndices(list), []int{1, 3})
+	assertEqual(t, "MinimumIndices", List.MinimumIndices(list), []int{2, 4})
+	assertEqual(t, "MinMax", List.MinMax(list), MaybeResult.Just(Tuple.Pair(1, 9)))
+	assertEqual(t, "MinMax of a single element", List.MinMax([]int{4}), MaybeResult.Just(Tuple.Pair(4, 4)))
+
+	empty := []int{}
+	assertEqual(t, "MaximumIndex of an empty list", List.MaximumIndex(empty), MaybeResult.Nothing[int]())
+	assertEqual(t, "MinimumIndex of an empty list", List.MinimumIndex(empty), MaybeResult.Nothing[int]())
+	assertEqual(t, "MaximumIndices of an empty list", len(List.MaximumIndices(empty)), 0)
+	assertEqual(t, "MinMax of an empty list", List.MinMax(empty), MaybeResult.Nothing[Tuple.Tuple[int, int]]())
+	assertEqual(t, "MaximumBy of an empty list", List.MaximumBy(Basics.Negate[int], empty), MaybeResult.Nothing[int]())
+	assertEqual(t, "MinMaxWith of an empty list", List.MinMaxWith(Basics.Compare[int], empty), MaybeResult.Nothing[Tuple.Tuple[int, int]]())
+}
+
+func TestExtremesBy(t *testing.T) {
+	people := []person{{"ann", 30}, {"bob", 25}, {"cid", 30}, {"dan", 25}, {"eve", 28}}
+	calls := 0
+	age := func(p person) int {
+		calls++
+		return p.age
+	}
+	byAge := Basics.Comparing(func(p person) int { return p.age })
+
+	assertEqual(t, "MaximumBy takes the first tie", List.MaximumBy(age, people), MaybeResult.Just(person{"ann", 30}))
+	assertEqual(t, "the calls of MaximumBy", calls, len(people))
+	assertEqual(t, "MinimumBy takes the first tie", List.MinimumBy(age, people), MaybeResult.Just(person{"bob", 25}))
+	assertEqual(t, "MaximumWith", List.MaximumWith(byAge, people), MaybeResult.Just(person{"ann", 30}))
+	assertEqual(t, "MinimumWith", List.MinimumWith(byAge, people), MaybeResult.Just(person{"bob", 25}))
+
+	assertEqual(t, "MaximumIndexBy", List.MaximumIndexBy(age, people), MaybeResult.Just(0))
+	assertEqual(t, "MinimumIndexBy", List.MinimumIndexBy(age, people), MaybeResult.Just(1))
+	assertEqual(t, "MaximumIndexWith", List.MaximumIndexWith(byAge, people), MaybeResult.Just(0))
+	assertEqual(t, "MinimumIndexWith", List.MinimumIndexWith(byAge, people), MaybeResult.Just(1))
+
+	assertEqual(t, "MaximumIndicesBy", List.MaximumIndicesBy(age, people), []int{0, 2})
+	assertEqual(t, "MinimumIndicesBy", List.MinimumIndicesBy(age, people), []int{1, 3})
+	assertEqual(t, "MaximumIndicesWith", List.MaximumIndicesWith(byAge, people), []int{0, 2})
+	assertEqual(t, "MinimumIndicesWith", List.MinimumIndicesWith(byAge, people), []int{1, 3})
+
+	calls = 0
+	assertEqual(t, "MinMaxBy takes the first ties", List.MinMaxBy(age, people), MaybeResult.Just(Tuple.Pair(person{"bob", 25}, person{"ann", 30})))
+	assertEqual(t, "the calls of MinMaxBy", calls, len(people))
+	assertEqual(t, "MinMaxWith", List.MinMaxWith(byAge, people), MaybeResult.Just(Tuple.Pair(person{"bob", 25}, person{"ann", 30})))
+}
+
+// The length of a longest common subsequence, with the textbook O(n * m) dynamic programming.
+func lcsLength(a, b []int) int {
+	table := make([][]int, len(a)+1)
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				table[i][j] = table[i-1][j-1] + 1
+			} else {
+				table[i][j] = Basics.Max(table[i-1][j], table[i][j-1])
+			}
+		}
+	}
+	return table[len(a)][len(b)]
+}
+
+// The Levenshtein distance, with the textbook O(n * m) dynamic programming.
+func levenshtein(a, b []int) int {
+	table := make([][]int, len(a)+1)
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+		table[i][0] = i
+	}
+	for j := range table[0] {
+		table[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			table[i][j] = Basics.Min(table[i-1][j]+1, table[i][j-1]+1, table[i-1][j-1]+cost)
+		}
+	}
+	return table[len(a)][len(b)]
+}
+
+func isSubsequence(sub, list []int) bool {
+	i := 0
+	for _, value := range list {
+		if i < len(sub) && sub[i] == value {
+			i++
+		}
+	}
+	return i == len(sub)
+}
+
+func TestDiffOnRandomLists(t *testing.T) {
+	random := rand.New(rand.NewSource(9))
+	randomList := func() []int {
+		// A small alphabet makes many common elements, and many equally short scripts to choose from.
+		list := make([]int, random.Intn(40))
+		for i := range list {
+			list[i] = random.Intn(4)
+		}
+		return list
+	}
+	for round := 0; round < 500; round++ {
+		a, b := randomList(), randomList()
+		edits := List.Diff(a, b)
+		assertEqual(t, "Patch(Diff(a, b), a)", List.Patch(edits, a), MaybeResult.Ok(b))
+
+		lcs := lcsLength(a, b)
+		changes, keeps := 0, 0
+		oldIndex, newIndex := 0, 0
+		for _, edit := range edits {
+			switch edit.Op {
+			case List.EditKeep:
+				keeps++
+				if edit.OldIndex != oldIndex || edit.NewIndex != newIndex || a[oldIndex] != b[newIndex] || edit.Value != a[oldIndex] {
+					t.Fatalf("Diff(%v, %v) keeps %v at the wrong indexes", a, b, edit)
+				}
+				oldIndex++
+				newIndex++
+			case List.EditDelete:
+				changes++
+				if edit.OldIndex != oldIndex || edit.NewIndex != -1 || edit.Value != a[oldIndex] {
+					t.Fatalf("Diff(%v, %v) deletes %v at the wrong index", a, b, edit)
+				}
+				oldIndex++
+			case List.EditInsert:
+				changes++
+				if edit.NewIndex != newIndex || edit.OldIndex != -1 || edit.Value != b[newIndex] {
+					t.Fatalf("Diff(%v, %v) inserts %v at the wrong index", a, b, edit)
+				}
+				newIndex++
+			}
+		}
+		if changes != len(a)+len(b)-2*lcs || keeps != lcs {
+			t.Fatalf("Diff(%v, %v) has %d changes and %d keeps, want %d and %d", a, b, changes, keeps, len(a)+len(b)-2*lcs, lcs)
+		}
+
+		common := List.LongestCommonSubsequence(a, b)
+		if len(common) != lcs || !isSubsequence(common, a) || !isSubsequence(common, b) {
+			t.Fatalf("LongestCommonSubsequence(%v, %v) = %v, want a common subsequence of length %d", a, b, common, lcs)
+		}
+		assertEqual(t, "LevenshteinDistance", List.LevenshteinDistance(a, b), levenshtein(a, b))
+	}
+}
+
+func TestDiff(t *testing.T) {
+	edits := List.Diff([]string{"a", "b", "c"}, []string{"a", "x", "c", "d"})
+	want := []List.Edit[string]{
+		{Op: List.EditKeep, OldIndex: 0, NewIndex: 0, Value: "a"},
+		{Op: List.EditDelete, OldIndex: 1, NewIndex: -1, Value: "b"},
+		{Op: List.EditInsert, OldIndex: -1, NewIndex: 1, Value: "x"},
+		{Op: List.EditKeep, OldIndex: 2, NewIndex: 2, Value: "c"},
+		{Op: List.EditInsert, OldIndex: -1, NewIndex: 3, Value: "d"},
+	}
+	assertEqual(t, "Diff", edits, want)
+	assertEqual(t, "the Edit String", edits[1].String()+edits[2].String()+edits[0].String(), "-b+x a")
+
+	assertEqual(t, "Diff of empty lists", len(List.Diff([]int{}, []int{})), 0)
+	assertEqual(t, "Diff from an empty list", List.Diff([]int{}, []int{1}), []List.Edit[int]{{Op: List.EditInsert, OldIndex: -1, NewIndex: 0, Value: 1}})
+	assertEqual(t, "Diff to an empty list", List.Diff([]int{1}, []int{}), []List.Edit[int]{{Op: List.EditDelete, OldIndex: 0, NewIndex: -1, Value: 1}})
+	for _, edit := range List.Diff([]int{1, 2, 3}, []int{1, 2, 3}) {
+		if edit.Op != List.EditKeep {
+			t.Errorf("Diff of equal lists has %v", edit)
+		}
+	}
+
+	caseless := func(a, b string) bool { return strings.EqualFold(a, b) }
+	assertEqual(t, "DiffWith", len(List.DiffWith(caseless, []string{"A", "b"}, []string{"a", "B"})), 2)
+	assertEqual(t, "LongestCommonSubsequenceWith keeps the first list", List.LongestCommonSubsequenceWith(caseless, []string{"A", "x", "b"}, []string{"a", "B"}), []string{"A", "b"})
+	assertEqual(t, "LevenshteinDistanceWith", List.LevenshteinDistanceWith(caseless, []string{"A", "b", "c"}, []string{"a", "B"}), 1)
+}
+
+func TestPatchRejectsAMismatchedScript(t *testing.T) {
+	edits := List.Diff([]int{1, 2, 3}, []int{1, 3})
+	failures := map[string][]int{
+		"another value":    {1, 5, 3},
+		"a shorter list":   {1, 2},
+		"a longer list":    {1, 2, 3, 4},
+		"an empty list":    {},
+		"shifted elements": {0, 1, 2, 3},
+	}
+	for name, list := range failures {
+		if r := List.Patch(edits, list); r.IsOk() {
+			t.Errorf("Patch of %s = %v, want an Err", name, r)
+		}
+	}
+	assertEqual(t, "Patch of no edits", List.Patch([]List.Edit[int]{}, []int{}), MaybeResult.Ok([]int{}))
+	caseless := func(a, b string) bool { return strings.EqualFold(a, b) }
+	assertEqual(t, "PatchWith", List.PatchWith(caseless, List.Diff([]string{"a"}, []string{"a", "b"}), []string{"A"}), MaybeResult.Ok([]string{"A", "b"}))
+}
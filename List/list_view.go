@@ -0,0 +1,101 @@
+package List
+
+import (
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
+// The functions in this file are ZERO-COPY, the lists they return are views into the given list.
+// Changing an element of a view changes the given list as well!
+// Every view has its capacity limited to its length, so appending to a view never overwrites its neighbours.
+
+// GROUP
+
+// Split a list into chunks of the given size.
+// The last chunk holds the remaining elements, and may be smaller.
+func Chunk_view[T any](size int, list []T) [][]T {
+	size = Basics.Max(size, 1)
+	chunks := make([][]T, 0, (len(list)+size-1)/size)
+	for start := 0; start < len(list); start += size {
+		stop := Basics.Min(start+size, len(list))
+		chunks = append(chunks, list[start:stop:stop])
+	}
+	return chunks
+}
+
+// Split a list into n chunks whose sizes differ by at most one, handy for spreading work over n workers.
+// There are never more chunks than elements, so an empty list results in no chunks.
+func ChunkEvenly_view[T any](n int, list []T) [][]T {
+	n = Basics.Min(Basics.Max(n, 1), len(list))
+	chunks := make([][]T, 0, n)
+	start := 0
+	for i := 0; i < n; i++ {
+		// The first len(list) % n chunks get one extra element.
+		stop := start + len(list)/n
+		if i < len(list)%n {
+			stop++
+		}
+		chunks = append(chunks, list[start:stop:stop])
+		start = stop
+	}
+	return chunks
+}
+
+// Get an iterator over the chunks of the given size, it returns Nothing once the list is exhausted.
+// The last chunk holds the remaining elements, and may be smaller.
+// Unlike `Chunk_view`, no list of chunks is allocated up front.
+func ChunkIterator_view[T any](size int, list []T) func() Maybe[[]T] {
+	size = Basics.Max(size, 1)
+	start := 0
+	return func() Maybe[[]T] {
+		if start >= len(list) {
+			return Nothing[[]T]()
+		}
+		stop := Basics.Min(start+size, len(list))
+		chunk := list[start:stop:stop]
+		start = stop
+		return Just(chunk)
+	}
+}
+
+// Get the sliding windows of the given size, starting a new window every `step` elements.
+// Windows that would run past the end of the list are not included.
+func Windows_view[T any](size int, step int, list []T) [][]T {
+	size = Basics.Max(size, 1)
+	step = Basics.Max(step, 1)
+	windows := make([][]T, 0, Basics.Max(len(list)-size+step, 0)/step)
+	for start := 0; start+size <= len(list); start += step {
+		windows = append(windows, list[start:start+size:start+size])
+	}
+	return windows
+}
+
+// Group consecutive elements, as long as every pair of neighbours satisfies the test.
+// The test is given the previous element and the next element.
+func GroupWhile_view[T any](testfn func(previous T, next T) bool, list []T) [][]T {
+	groups := make([][]T, 0, 8)
+	start := 0
+	for i := 1; i <= len(list); i++ {
+		if i == len(list) || !testfn(list[i-1], list[i]) {
+			groups = append(groups, list[start:i:i])
+			start = i
+		}
+	}
+	return groups
+}
+
+// Group consecutive elements that have an equal derived property.
+// Equal keys that are not next to each other end up in different groups, sort the list first to avoid that.
+func GroupBy_view[T any, U comparable](mapfn func(value T) U, list []T) [][]T {
+	return GroupWhile_view(func(previous T, next T) bool {
+		return mapfn(previous) == mapfn(next)
+	}, list)
+}
+
+// Split a list before every element that satisfies the test, except the first element.
+// No elements are dropped, every element that satisfies the test starts a new group.
+func SplitWhen_view[T any](testfn func(value T) bool, list []T) [][]T {
+	return GroupWhile_view(func(previous T, next T) bool {
+		return !testfn(next)
+	}, list)
+}
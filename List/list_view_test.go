@@ -0,0 +1,64 @@
+package List_test
+
+import (
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/List"
+)
+
+func TestChunk_view(t *testing.T) {
+	list := []int{1, 2, 3, 4, 5}
+	assertEqual(t, "Chunk_view", List.Chunk_view(2, list), [][]int{{1, 2}, {3, 4}, {5}})
+	assertEqual(t, "Chunk_view of an exact fit", List.Chunk_view(5, list), [][]int{{1, 2, 3, 4, 5}})
+	assertEqual(t, "Chunk_view of a size below one", List.Chunk_view(0, list), [][]int{{1}, {2}, {3}, {4}, {5}})
+	assertEqual(t, "Chunk_view of an empty list", len(List.Chunk_view(3, []int{})), 0)
+
+	assertEqual(t, "ChunkEvenly_view", List.ChunkEvenly_view(3, []int{1, 2, 3, 4, 5, 6, 7}), [][]int{{1, 2, 3}, {4, 5}, {6, 7}})
+	assertEqual(t, "ChunkEvenly_view of more chunks than elements", List.ChunkEvenly_view(4, []int{1, 2}), [][]int{{1}, {2}})
+	assertEqual(t, "ChunkEvenly_view of an empty list", len(List.ChunkEvenly_view(4, []int{})), 0)
+
+	next := List.ChunkIterator_view(2, list)
+	for _, want := range [][]int{{1, 2}, {3, 4}, {5}} {
+		assertEqual(t, "ChunkIterator_view", next().Expect(), want)
+	}
+	if next().IsJust() || next().IsJust() {
+		t.Error("ChunkIterator_view goes on after the list is exhausted")
+	}
+}
+
+func TestViewsShareTheList(t *testing.T) {
+	list := []int{1, 2, 3, 4, 5}
+	chunks := List.Chunk_view(2, list)
+	chunks[1][0] = 30
+	assertEqual(t, "the list after changing a view", list, []int{1, 2, 30, 4, 5})
+	// The capacity is limited, appending to a view copies instead of overwriting the next view.
+	grown := append(chunks[0], 99)
+	grown[0] = 10
+	assertEqual(t, "the list after appending to a view", list, []int{1, 2, 30, 4, 5})
+	for _, view := range List.Windows_view(2, 1, list) {
+		if cap(view) != len(view) {
+			t.Errorf("Windows_view has capacity %d for %d elements", cap(view), len(view))
+		}
+	}
+}
+
+func TestWindows_view(t *testing.T) {
+	list := []int{1, 2, 3, 4, 5}
+	assertEqual(t, "Windows_view", List.Windows_view(3, 1, list), [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}})
+	assertEqual(t, "Windows_view with a step", List.Windows_view(2, 2, list), [][]int{{1, 2}, {3, 4}})
+	assertEqual(t, "Windows_view with a step past the size", List.Windows_view(1, 3, list), [][]int{{1}, {4}})
+	assertEqual(t, "Windows_view larger than the list", len(List.Windows_view(6, 1, list)), 0)
+	assertEqual(t, "Windows_view of an empty list", len(List.Windows_view(1, 1, []int{})), 0)
+}
+
+func TestGroup_view(t *testing.T) {
+	ascending := func(previous, next int) bool { return previous < next }
+	assertEqual(t, "GroupWhile_view", List.GroupWhile_view(ascending, []int{1, 2, 5, 3, 4, 1}), [][]int{{1, 2, 5}, {3, 4}, {1}})
+	assertEqual(t, "GroupWhile_view of an empty list", len(List.GroupWhile_view(ascending, []int{})), 0)
+	assertEqual(t, "GroupWhile_view of a single element", List.GroupWhile_view(ascending, []int{7}), [][]int{{7}})
+
+	isEven := func(n int) bool { return n%2 == 0 }
+	assertEqual(t, "GroupBy_view", List.GroupBy_view(isEven, []int{2, 4, 1, 3, 6, 5}), [][]int{{2, 4}, {1, 3}, {6}, {5}})
+	assertEqual(t, "SplitWhen_view", List.SplitWhen_view(isEven, []int{2, 1, 4, 6, 3}), [][]int{{2, 1}, {4}, {6, 3}})
+	assertEqual(t, "SplitWhen_view without a match", List.SplitWhen_view(isEven, []int{1, 3}), [][]int{{1, 3}})
+}
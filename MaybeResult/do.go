@@ -0,0 +1,81 @@
+package MaybeResult
+
+// Carries the state of a `Do` or `DoMaybe` block.
+// It is only valid inside the function given to `Do` or `DoMaybe`,
+// and must not be used after that function has returned or from another goroutine.
+type Binder struct {
+	done bool
+}
+
+// The value used to unwind the stack of a `Do` block when a bound computation failed.
+// It never escapes the `Do` or `DoMaybe` function that created the Binder.
+type bindFailure struct {
+	binder *Binder
+	err    error
+	trace  *callStack
+}
+
+// Run a block of imperative looking code with `Result` semantics.
+// Every `Bind` inside the block unwraps an `Ok` value,
+// the first `Err` stops the block and becomes the returned `Result`.
+//
+//	r := Do(func(b *Binder) int {
+//		x := Bind(b, parse(a))
+//		y := Bind(b, parse(c))
+//		return x + y
+//	})
+//
+// Panics not caused by `Bind` are recovered and raised again from within `Do`, with the same value.
+// The original frames stay below the re-raise in the stack trace, which is marked as [recovered].
+func Do[T any](fn func(b *Binder) T) (result Result[T]) {
+	b := &Binder{}
+	defer func() {
+		b.done = true
+		if rec := recover(); rec != nil {
+			failure, ok := rec.(bindFailure)
+			if !ok || failure.binder != b {
+				panic(rec)
+			}
+			result = Result[T]{err: failure.err, trace: failure.trace}
+		}
+	}()
+	return Ok(fn(b))
+}
+
+// Run a block of imperative looking code with `Maybe` semantics.
+// Every `Bind` or `BindMaybe` inside the block unwraps its value,
+// the first `Err` or `Nothing` stops the block and makes the whole block `Nothing`.
+//
+// Panics not caused by `Bind` or `BindMaybe` are raised again with the same value, as in `Do`.
+func DoMaybe[T any](fn func(b *Binder) T) Maybe[T] {
+	return Do(fn).Ok()
+}
+
+// Unwrap a `Result` inside of a `Do` block.
+//
+//	Ok(T)    => T
+//	Err(err) => the enclosing `Do` returns Err(err)
+func Bind[T any](b *Binder, r Result[T]) T {
+	if r.IsErr() {
+		b.fail(r.err, r.trace)
+	}
+	return r.value
+}
+
+// Unwrap a `Maybe` inside of a `Do` block.
+//
+//	Just(T) => T
+//	Nothing => the enclosing `Do` returns Err(ErrNothing)
+func BindMaybe[T any](b *Binder, m Maybe[T]) T {
+	if !m.isJust {
+		b.fail(ErrNothing, withStack(ErrNothing, 1))
+	}
+	return m.value
+}
+
+func (b *Binder) fail(err error, trace *callStack) {
+	if b.done {
+		panic("Bind called with a Binder whose `Do` block has already returned.")
+	}
+	panic(bindFailure{binder: b, err: err, trace: trace})
+}
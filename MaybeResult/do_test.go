@@ -0,0 +1,101 @@
+package MaybeResult_test
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
+func parse(s string) Result[int] {
+	return ErrToResult(strconv.Atoi(s))
+}
+
+func TestDoOk(t *testing.T) {
+	r := Do(func(b *Binder) int {
+		return Bind(b, parse("1")) + Bind(b, parse("2"))
+	})
+	if !r.IsOk() || r.Expect() != 3 {
+		t.Errorf("Do = %v, want Ok(3)", r)
+	}
+}
+
+func TestDoStopsAtFirstErr(t *testing.T) {
+	reached := false
+	r := Do(func(b *Binder) int {
+		x := Bind(b, parse("1"))
+		y := Bind(b, Err[int](io.EOF))
+		reached = true
+		return x + y
+	})
+	if r.Unwrap() != io.EOF {
+		t.Errorf("Do = %v, want Err(EOF)", r)
+	}
+	if reached {
+		t.Error("Do kept running after a failed Bind")
+	}
+}
+
+func TestDoBindMaybe(t *testing.T) {
+	r := Do(func(b *Binder) int {
+		return BindMaybe(b, Just(1)) + BindMaybe(b, Nothing[int]())
+	})
+	if !errors.Is(r, ErrNothing) {
+		t.Errorf("Do = %v, want Err(ErrNothing)", r)
+	}
+}
+
+func TestDoMaybe(t *testing.T) {
+	if m := DoMaybe(func(b *Binder) int { return BindMaybe(b, Just(2)) * 2 }); m.WithDefault(0) != 4 {
+		t.Errorf("DoMaybe = %v, want Just(4)", m)
+	}
+	if m := DoMaybe(func(b *Binder) int { return Bind(b, Err[int](io.EOF)) }); m.IsJust() {
+		t.Errorf("DoMaybe = %v, want Nothing", m)
+	}
+}
+
+func TestDoNestedBindersFailTheirOwnBlock(t *testing.T) {
+	innerResult := Ok(0)
+	outer := Do(func(outer *Binder) int {
+		innerResult = Do(func(inner *Binder) int {
+			// Binding with the outer Binder fails the outer block, not the inner one.
+			return Bind(outer, Err[int](io.EOF))
+		})
+		return 1
+	})
+	if outer.Unwrap() != io.EOF {
+		t.Errorf("outer Do = %v, want Err(EOF)", outer)
+	}
+	if !innerResult.IsOk() {
+		t.Errorf("the inner Do should not have returned, got %v", innerResult)
+	}
+}
+
+func TestDoRepanicsOtherPanics(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	defer func() {
+		if rec := recover(); rec != sentinel {
+			t.Errorf("recovered %v, want the original panic value", rec)
+		}
+	}()
+	Do(func(b *Binder) int {
+		panic(sentinel)
+	})
+	t.Error("Do returned instead of panicking")
+}
+
+func TestBindAfterDoReturnedPanics(t *testing.T) {
+	var escaped *Binder
+	Do(func(b *Binder) int {
+		escaped = b
+		return 0
+	})
+	defer func() {
+		if recover() == nil {
+			t.Error("Bind on a finished Binder did not panic")
+		}
+	}()
+	Bind(escaped, Err[int](io.EOF))
+}
@@ -7,6 +7,11 @@ import (
 
 var ErrNothing = errors.New("Maybe is of the `Nothing` variant.")
 
+// A value that is computed on demand, such as a `Lazy.Lazy`.
+type Deferred[T any] interface {
+	Force() T
+}
+
 // Represent values that may or may not exist.
 // It can be useful if you have a record field that is only filled in sometimes.
 // Or if a function takes a value sometimes, but does not absolutely need it.
@@ -112,6 +117,18 @@ func (m Maybe[T]) OrElse(fn func() T) T {
 	return fn()
 }
 
+// Unwrap the `Maybe` type and get the underlying value, or force the deferred default value.
+// This is useful with a memoized default like `Lazy.Lazy`, that is computed at most once.
+//
+//	Just(T) => T
+//	Nothing => deferred.Force()
+func (m Maybe[T]) OrLazy(deferred Deferred[T]) T {
+	if m.isJust {
+		return m.value
+	}
+	return deferred.Force()
+}
+
 // Execute the fn on the underlying value if the `Maybe` is Just
 // returning a `Maybe` with the returned value.
 //
@@ -191,6 +208,51 @@ func (m Maybe[T]) ToResult() Result[T] {
 	return m.OkOr(fmt.Errorf("Maybe was Nothing."))
 }
 
+// PATTERN MATCHING
+
+// Exhaustively match on a `Maybe`, producing a value of any type.
+// Exactly one of the two functions is called.
+//
+//	Just(T) => onJust(T)
+//	Nothing => onNothing()
+func Match[T, U any](m Maybe[T], onJust func(value T) U, onNothing func() U) U {
+	if m.isJust {
+		return onJust(m.value)
+	}
+	return onNothing()
+}
+
+// COMPARISON
+
+// Create a comparison function for `Maybe` values, that puts Nothing before every Just.
+// Two Just values are compared with the given comparison function, such as `Basics.Compare`.
+func CompareNullsFirst[T any](cmpfn func(a, b T) int) func(a, b Maybe[T]) int {
+	return func(a, b Maybe[T]) int {
+		switch {
+		case !a.isJust && !b.isJust:
+			return 0
+		case !a.isJust:
+			return -1
+		case !b.isJust:
+			return 1
+		default:
+			return cmpfn(a.value, b.value)
+		}
+	}
+}
+
+// Create a comparison function for `Maybe` values, that puts Nothing after every Just.
+// Two Just values are compared with the given comparison function, such as `Basics.Compare`.
+func CompareNullsLast[T any](cmpfn func(a, b T) int) func(a, b Maybe[T]) int {
+	nullsFirst := CompareNullsFirst(cmpfn)
+	return func(a, b Maybe[T]) int {
+		if a.isJust != b.isJust {
+			return -nullsFirst(a, b)
+		}
+		return nullsFirst(a, b)
+	}
+}
+
 // INTERFACE IMPLEMENTATIONS
 
 func (m Maybe[T]) Format(f fmt.State, c rune) {
@@ -0,0 +1,82 @@
+package MaybeResult_test
+
+import (
+	"testing"
+
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
+func TestMatch(t *testing.T) {
+	describe := func(m Maybe[int]) string {
+		return Match(m, func(value int) string {
+			if value > 0 {
+				return "positive"
+			}
+			return "not positive"
+		}, func() string {
+			return "nothing"
+		})
+	}
+	cases := []struct {
+		m    Maybe[int]
+		want string
+	}{
+		{Just(3), "positive"},
+		{Just(0), "not positive"},
+		{Nothing[int](), "nothing"},
+	}
+	for _, c := range cases {
+		if got := describe(c.m); got != c.want {
+			t.Errorf("Match(%v) = %q, want %q", c.m, got, c.want)
+		}
+	}
+}
+
+func TestMatchCallsExactlyOneBranch(t *testing.T) {
+	justCalls, nothingCalls := 0, 0
+	onJust := func(value int) int { justCalls++; return value }
+	onNothing := func() int { nothingCalls++; return -1 }
+
+	Match(Just(1), onJust, onNothing)
+	if justCalls != 1 || nothingCalls != 0 {
+		t.Errorf("Match(Just) called onJust %d times and onNothing %d times", justCalls, nothingCalls)
+	}
+	Match(Nothing[int](), onJust, onNothing)
+	if justCalls != 1 || nothingCalls != 1 {
+		t.Errorf("after Match(Nothing) onJust was called %d times and onNothing %d times, want 1 and 1", justCalls, nothingCalls)
+	}
+}
+
+func TestCompareNulls(t *testing.T) {
+	compareInts := func(a, b int) int { return a - b }
+	nullsFirst := CompareNullsFirst(compareInts)
+	nullsLast := CompareNullsLast(compareInts)
+	cases := []struct {
+		a, b        Maybe[int]
+		first, last int
+	}{
+		{Nothing[int](), Nothing[int](), 0, 0},
+		{Nothing[int](), Just(1), -1, 1},
+		{Just(1), Nothing[int](), 1, -1},
+		{Just(1), Just(2), -1, -1},
+		{Just(2), Just(2), 0, 0},
+		{Just(3), Just(2), 1, 1},
+	}
+	sign := func(n int) int {
+		switch {
+		case n < 0:
+			return -1
+		case n > 0:
+			return 1
+		}
+		return 0
+	}
+	for _, c := range cases {
+		if got := sign(nullsFirst(c.a, c.b)); got != c.first {
+			t.Errorf("CompareNullsFirst(%v, %v) = %d, want %d", c.a, c.b, got, c.first)
+		}
+		if got := sign(nullsLast(c.a, c.b)); got != c.last {
+			t.Errorf("CompareNullsLast(%v, %v) = %d, want %d", c.a, c.b, got, c.last)
+		}
+	}
+}
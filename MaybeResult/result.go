@@ -7,9 +7,14 @@ import (
 
 // A Result is either Ok meaning the computation succeeded,
 // or it is an Err meaning that there was some failure.
+//
+// While `CaptureStackTraces` is enabled, every `Err` carries its own stack,
+// so two Results holding the same error are no longer ==. Compare their errors with `errors.Is` instead.
 type Result[T any] struct {
 	err   error
 	value T
+	// The stack where the error entered the Result, see `CaptureStackTraces`.
+	trace *callStack
 }
 
 // CREATION
@@ -18,11 +23,17 @@ func ErrToResult[T any](value T, err error) Result[T] {
 	return Result[T]{
 		err:   err,
 		value: value,
+		trace: withStack(err, 1),
 	}
 }
 
 func Try[T any](fn func() (T, error)) Result[T] {
-	return ErrToResult(fn())
+	value, err := fn()
+	return Result[T]{
+		err:   err,
+		value: value,
+		trace: withStack(err, 1),
+	}
 }
 
 // Create the 'Ok' variant of the Result type.
@@ -32,11 +43,12 @@ func Ok[T any](value T) Result[T] {
 
 // Create the 'Err' variant of the Result type.
 func Err[T any](err error) Result[T] {
-	return Result[T]{err: err}
+	return Result[T]{err: err, trace: withStack(err, 1)}
 }
 
 func Errf[T any](format string, a ...any) Result[T] {
-	return Err[T](fmt.Errorf(format, a...))
+	err := fmt.Errorf(format, a...)
+	return Result[T]{err: err, trace: withStack(err, 1)}
 }
 
 // METHODS
@@ -92,6 +104,18 @@ func (r Result[T]) OrElse(fn func(err error) T) T {
 	return fn(r.err)
 }
 
+// Unwrap the `Result` type and get the underlying value, or force the deferred default value.
+// This is useful with a memoized default like `Lazy.Lazy`, that is computed at most once.
+//
+//	Ok(T)    => T
+//	Err(err) => deferred.Force()
+func (r Result[T]) OrLazy(deferred Deferred[T]) T {
+	if r.IsOk() {
+		return r.value
+	}
+	return deferred.Force()
+}
+
 func (r Result[T]) Map(fn func(value T) T) Result[T] {
 	if r.IsOk() {
 		return Result[T]{
@@ -101,13 +125,41 @@ func (r Result[T]) Map(fn func(value T) T) Result[T] {
 	return r
 }
 
+// Transform the error of an `Err`.
+// The captured stack is kept when the new error wraps the old one,
+// otherwise the stack is captured anew at the call to MapErr (see `CaptureStackTraces`).
 func (r Result[T]) MapErr(fn func(err error) error) Result[T] {
 	if r.IsOk() {
 		return r
 	}
-	return Result[T]{
-		err: fn(r.err),
+	err := fn(r.err)
+	if errors.Is(err, r.err) {
+		return Result[T]{err: err, trace: r.trace}
 	}
+	return Result[T]{err: err, trace: withStack(err, 1)}
+}
+
+// Add a layer of context to the error of an `Err`.
+// The original error is kept, so `errors.Is` and `errors.As` still find it.
+//
+//	Ok(T)    => Ok(T)
+//	Err(err) => Err("msg: err")
+func (r Result[T]) Context(msg string) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return Result[T]{err: withContext(msg, r.err, 1), trace: r.trace}
+}
+
+// Same as `Context` but the message is formatted like `fmt.Sprintf`.
+//
+//	Ok(T)    => Ok(T)
+//	Err(err) => Err("format: err")
+func (r Result[T]) Wrap(format string, a ...any) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return Result[T]{err: withContext(fmt.Sprintf(format, a...), r.err, 1), trace: r.trace}
 }
 
 func (r Result[T]) AndThen(fn func(value T) Result[T]) Result[T] {
@@ -142,13 +194,75 @@ func (r Result[T]) ToMaybe() Maybe[T] {
 	return r.Ok()
 }
 
+// TRANSFORM
+
+// Transform the value of an `Ok` into a value of another type.
+// An `Err` keeps its error and its captured stack.
+//
+//	Ok(T)    => Ok(mapfn(T))
+//	Err(err) => Err(err)
+func MapResult[T, U any](mapfn func(value T) U, r Result[T]) Result[U] {
+	if r.IsOk() {
+		return Ok(mapfn(r.value))
+	}
+	return Result[U]{err: r.err, trace: r.trace}
+}
+
+// Chain together computations that may fail, with a value of another type.
+// An `Err` keeps its error and its captured stack.
+//
+//	Ok(T)    => fn(T)
+//	Err(err) => Err(err)
+func AndThenResult[T, U any](fn func(value T) Result[U], r Result[T]) Result[U] {
+	if r.IsOk() {
+		return fn(r.value)
+	}
+	return Result[U]{err: r.err, trace: r.trace}
+}
+
+// PATTERN MATCHING
+
+// Exhaustively match on a `Result`, producing a value of any type.
+// Exactly one of the two functions is called.
+//
+//	Ok(T)    => onOk(T)
+//	Err(err) => onErr(err)
+func Fold[T, U any](r Result[T], onOk func(value T) U, onErr func(err error) U) U {
+	if r.IsOk() {
+		return onOk(r.value)
+	}
+	return onErr(r.err)
+}
+
+// Handle the error of a `Result` only when it is (or wraps) an error of type E,
+// as determined by `errors.As`. Any other `Result` is returned unchanged.
+//
+//	Ok(T)                   => Ok(T)
+//	Err(err) where err is E => handler(E)
+//	Err(err)                => Err(err)
+func MatchErr[E error, T any](r Result[T], handler func(err E) Result[T]) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	var target E
+	if errors.As(r.err, &target) {
+		return handler(target)
+	}
+	return r
+}
+
 // INTERFACE IMPLEMENTATIONS
 
+// Formatting with `%+v` prints every layer of the error chain on its own line,
+// together with the stack frames captured for it (see `CaptureStackTraces`).
 func (r Result[T]) Format(f fmt.State, c rune) {
 	if r.IsOk() {
 		_, _ = f.Write([]byte("Ok(" + fmt.Sprint(r.value) + ")"))
 	} else {
 		_, _ = f.Write([]byte("Err(" + r.err.Error() + ")"))
+		if c == 'v' && f.Flag('+') {
+			writeErrorChain(f, r.err, r.trace)
+		}
 	}
 }
 
@@ -178,6 +292,10 @@ func (r Result[T]) As(target any) bool {
 	return errors.As(r.err, target)
 }
 
+func (r Result[T]) stack() *callStack {
+	return r.trace
+}
+
 // For the use with the errors.Is function.
 func (r Result[T]) Is(err error) bool {
 	if r.IsOk() {
@@ -0,0 +1,71 @@
+package MaybeResult_test
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"testing"
+
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
+func TestFold(t *testing.T) {
+	onOk := func(value int) string { return "ok" }
+	onErr := func(err error) string { return "err: " + err.Error() }
+
+	if got := Fold(Ok(1), onOk, onErr); got != "ok" {
+		t.Errorf("Fold(Ok) = %q, want %q", got, "ok")
+	}
+	if got := Fold(Err[int](io.EOF), onOk, onErr); got != "err: EOF" {
+		t.Errorf("Fold(Err) = %q, want %q", got, "err: EOF")
+	}
+}
+
+func TestMatchErr(t *testing.T) {
+	recovered := func(err *fs.PathError) Result[string] {
+		return Ok("recovered " + err.Path)
+	}
+
+	pathErr := &fs.PathError{Op: "open", Path: "a.txt", Err: fs.ErrNotExist}
+	cases := []struct {
+		name string
+		r    Result[string]
+		want Result[string]
+	}{
+		{"ok is unchanged", Ok("value"), Ok("value")},
+		{"matching error is handled", Err[string](pathErr), Ok("recovered a.txt")},
+		{"wrapped matching error is handled", Err[string](errors.Join(io.EOF, pathErr)), Ok("recovered a.txt")},
+		{"other error is unchanged", Err[string](io.EOF), Err[string](io.EOF)},
+	}
+	for _, c := range cases {
+		got := MatchErr(c.r, recovered)
+		if got.IsOk() != c.want.IsOk() || got.OrDefault() != c.want.OrDefault() || got.Unwrap() != c.want.Unwrap() {
+			t.Errorf("%s: MatchErr(%v) = %v, want %v", c.name, c.r, got, c.want)
+		}
+	}
+}
+
+func TestMapResultAndAndThenResult(t *testing.T) {
+	length := func(s string) int { return len(s) }
+	if got := MapResult(length, Ok("abc")); got.Expect() != 3 {
+		t.Errorf("MapResult(Ok) = %v", got)
+	}
+	if got := MapResult(length, Err[string](io.EOF)); got.Unwrap() != io.EOF {
+		t.Errorf("MapResult(Err) = %v", got)
+	}
+	positive := func(n int) Result[string] {
+		if n <= 0 {
+			return Errf[string]("%d is not positive", n)
+		}
+		return Ok("positive")
+	}
+	if got := AndThenResult(positive, Ok(1)); got.Expect() != "positive" {
+		t.Errorf("AndThenResult(Ok(1)) = %v", got)
+	}
+	if got := AndThenResult(positive, Ok(0)); got.IsOk() || got.Unwrap().Error() != "0 is not positive" {
+		t.Errorf("AndThenResult(Ok(0)) = %v", got)
+	}
+	if got := AndThenResult(positive, Err[int](io.EOF)); got.Unwrap() != io.EOF {
+		t.Errorf("AndThenResult(Err) = %v", got)
+	}
+}
@@ -0,0 +1,162 @@
+package MaybeResult
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sync/atomic"
+)
+
+// The maximum amount of stack frames captured for a single error.
+const maxStackDepth = 32
+
+var captureStack atomic.Bool
+
+// Enable or disable capturing the caller's stack frames whenever an `Err` is created
+// through `Err`, `Errf`, `ErrToResult` or `Try`, or when context is added with `Context` or `Wrap`.
+// Capturing is disabled by default since walking the stack is not free.
+//
+// The frames are stored in the `Result` next to the error, the error itself is left untouched,
+// so `Unwrap` and `Err` still return the very error that was given.
+// The captured frames are printed when formatting a `Result` with `%+v`,
+// and are returned by `StackTrace`.
+//
+// Since every `Err` holds its own stack, two Results with the same error are not == while capturing,
+// code comparing Results with == has to compare their errors with `errors.Is` instead.
+func CaptureStackTraces(enabled bool) {
+	captureStack.Store(enabled)
+}
+
+// The stack at the point an error entered a `Result`.
+// It is held by pointer, so that a `Result` stays comparable.
+type callStack struct {
+	pcs []uintptr
+}
+
+// An error that carries the stack at the point it entered a `Result`, implemented by `Result`.
+type tracer interface {
+	error
+	stack() *callStack
+}
+
+// An error wrapped with an additional layer of context.
+type contextError struct {
+	msg    string
+	err    error
+	frames []uintptr
+}
+
+func (e *contextError) Error() string {
+	return e.msg + ": " + e.err.Error()
+}
+
+func (e *contextError) Unwrap() error {
+	return e.err
+}
+
+// Get the program counters of the current goroutine's stack.
+// skip is the number of frames to skip, not counting callers itself,
+// so skip = 0 starts at the function calling callers.
+func callers(skip int) []uintptr {
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(skip+2, pcs)
+	return pcs[:n]
+}
+
+// Capture the current stack for the error if capturing is enabled and the error does not carry one yet.
+// skip is the number of frames to skip, skip = 0 starts at the function calling withStack.
+func withStack(err error, skip int) *callStack {
+	if err == nil || !captureStack.Load() {
+		return nil
+	}
+	var traced tracer
+	if errors.As(err, &traced) && traced.stack() != nil {
+		return nil
+	}
+	return &callStack{callers(skip + 1)}
+}
+
+// Wrap the error with a message, capturing the stack if enabled.
+// skip is the number of frames to skip, skip = 0 starts at the function calling withContext.
+func withContext(msg string, err error, skip int) error {
+	wrapped := &contextError{msg: msg, err: err}
+	if captureStack.Load() {
+		wrapped.frames = callers(skip + 1)
+	}
+	return wrapped
+}
+
+// Get the stack frames captured for the error, looking through its wrapped errors.
+// The error can be a `Result` or wrap one.
+// The frames of the innermost captured stack are returned,
+// which is the closest to where the error originated.
+// If no stack was captured, the returned list is empty.
+func StackTrace(err error) []runtime.Frame {
+	var pcs []uintptr
+	// The stack of a `Result` is where its error originated,
+	// the layers of context it holds were added later and cannot be closer to the origin.
+	origin := false
+	for err != nil {
+		switch e := err.(type) {
+		case *contextError:
+			if len(e.frames) > 0 && !origin {
+				pcs = e.frames
+			}
+		case tracer:
+			if stack := e.stack(); stack != nil {
+				pcs = stack.pcs
+				origin = true
+			}
+		}
+		err = errors.Unwrap(err)
+	}
+	return resolveFrames(pcs)
+}
+
+func resolveFrames(pcs []uintptr) []runtime.Frame {
+	list := make([]runtime.Frame, 0, len(pcs))
+	if len(pcs) == 0 {
+		return list
+	}
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		list = append(list, frame)
+		if !more {
+			break
+		}
+	}
+	return list
+}
+
+// Write every layer of the error with its captured frames, one layer per line.
+// The origin frames belong to the innermost layer, where the error was created.
+func writeErrorChain(w io.Writer, err error, origin *callStack) {
+	for err != nil {
+		switch e := err.(type) {
+		case *contextError:
+			writeLayer(w, e.msg, e.frames)
+			err = e.err
+		case tracer:
+			if stack := e.stack(); stack != nil {
+				origin = stack
+			}
+			err = errors.Unwrap(e)
+		default:
+			var pcs []uintptr
+			if origin != nil {
+				pcs = origin.pcs
+			}
+			writeLayer(w, e.Error(), pcs)
+			return
+		}
+	}
+}
+
+func writeLayer(w io.Writer, msg string, pcs []uintptr) {
+	_, _ = io.WriteString(w, "\n    "+msg)
+	for _, frame := range resolveFrames(pcs) {
+		_, _ = fmt.Fprintf(w, "\n        at %s (%s:%d)", frame.Function, frame.File, frame.Line)
+	}
+}
@@ -0,0 +1,136 @@
+package MaybeResult_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
+// Enable capturing for the duration of a test.
+func captureStacks(t *testing.T) {
+	CaptureStackTraces(true)
+	t.Cleanup(func() { CaptureStackTraces(false) })
+}
+
+func failingRead() Result[int] {
+	return Err[int](io.EOF)
+}
+
+func topFunction(t *testing.T, r Result[int]) string {
+	t.Helper()
+	frames := StackTrace(r)
+	if len(frames) == 0 {
+		t.Fatalf("StackTrace(%v) is empty", r)
+	}
+	return frames[0].Function
+}
+
+func TestNoStackTraceByDefault(t *testing.T) {
+	if frames := StackTrace(failingRead()); len(frames) != 0 {
+		t.Errorf("StackTrace without capturing = %v, want no frames", frames)
+	}
+}
+
+func TestStackTraceStartsWhereTheErrWasCreated(t *testing.T) {
+	captureStacks(t)
+	r := failingRead()
+	if got := topFunction(t, r); !strings.HasSuffix(got, ".failingRead") {
+		t.Errorf("top frame = %s, want failingRead", got)
+	}
+	if got := topFunction(t, ErrToResult(0, io.EOF)); !strings.HasSuffix(got, ".TestStackTraceStartsWhereTheErrWasCreated") {
+		t.Errorf("top frame of ErrToResult = %s, want the test function", got)
+	}
+}
+
+func TestCapturingKeepsTheOriginalError(t *testing.T) {
+	captureStacks(t)
+	r := failingRead()
+	if r.Unwrap() != io.EOF {
+		t.Errorf("Unwrap = %#v, want io.EOF", r.Unwrap())
+	}
+	if r.Err().Expect() != io.EOF {
+		t.Errorf("Err = %#v, want io.EOF", r.Err().Expect())
+	}
+	if !errors.Is(r, io.EOF) {
+		t.Error("errors.Is(r, io.EOF) = false")
+	}
+}
+
+func TestContextKeepsTheOriginStack(t *testing.T) {
+	captureStacks(t)
+	r := failingRead().Context("reading config").Wrap("loading %s", "app")
+	if got := r.Unwrap().Error(); got != "loading app: reading config: EOF" {
+		t.Errorf("error = %q", got)
+	}
+	if !errors.Is(r, io.EOF) {
+		t.Error("the wrapped error lost io.EOF")
+	}
+	if got := topFunction(t, r); !strings.HasSuffix(got, ".failingRead") {
+		t.Errorf("top frame = %s, want failingRead", got)
+	}
+}
+
+func TestStackTraceThroughWrappedResult(t *testing.T) {
+	captureStacks(t)
+	outer := Err[int](fmt.Errorf("outer: %w", failingRead()))
+	if got := topFunction(t, outer); !strings.HasSuffix(got, ".failingRead") {
+		t.Errorf("top frame = %s, want the innermost failingRead", got)
+	}
+}
+
+func TestFormatPlusV(t *testing.T) {
+	captureStacks(t)
+	r := failingRead().Context("reading")
+	text := fmt.Sprintf("%+v", r)
+	lines := strings.Split(text, "\n")
+	if lines[0] != "Err(reading: EOF)" {
+		t.Errorf("first line = %q", lines[0])
+	}
+	if !strings.Contains(text, "\n    reading\n") || !strings.Contains(text, "\n    EOF\n") {
+		t.Errorf("%%+v does not list every layer:\n%s", text)
+	}
+	if !strings.Contains(text, ".failingRead (") {
+		t.Errorf("%%+v does not show the origin frame:\n%s", text)
+	}
+	if plain := fmt.Sprintf("%v", r); plain != "Err(reading: EOF)" {
+		t.Errorf("%%v = %q", plain)
+	}
+}
+
+func remapError(r Result[int]) Result[int] {
+	return r.MapErr(func(err error) error { return errors.New("replaced") })
+}
+
+func TestMapErrStack(t *testing.T) {
+	captureStacks(t)
+	r := failingRead()
+	wrapped := r.MapErr(func(err error) error { return fmt.Errorf("wrapped: %w", err) })
+	if got := topFunction(t, wrapped); !strings.HasSuffix(got, ".failingRead") {
+		t.Errorf("wrapping MapErr top frame = %s, want failingRead", got)
+	}
+	if got := topFunction(t, remapError(r)); !strings.HasSuffix(got, ".remapError") {
+		t.Errorf("replacing MapErr top frame = %s, want remapError", got)
+	}
+}
+
+func TestDoKeepsTheStackOfTheBoundErr(t *testing.T) {
+	captureStacks(t)
+	r := Do(func(b *Binder) int { return Bind(b, failingRead()) })
+	if got := topFunction(t, r); !strings.HasSuffix(got, ".failingRead") {
+		t.Errorf("top frame = %s, want failingRead", got)
+	}
+}
+func TestMapResultKeepsTheStack(t *testing.T) {
+	captureStacks(t)
+	r := MapResult(func(value int) string { return "" }, failingRead())
+	if r.Unwrap() != io.EOF {
+		t.Errorf("MapResult = %v, want Err(EOF)", r)
+	}
+	if frames := StackTrace(r); len(frames) == 0 || !strings.HasSuffix(frames[0].Function, ".failingRead") {
+		t.Errorf("MapResult lost the stack of the Err")
+	}
+}
@@ -0,0 +1,176 @@
+package Queue
+
+import (
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
+// A mutable double-ended queue, implemented as a growable ring buffer.
+// Pushing and popping on both ends takes amortized O(1) time, and indexing takes O(1) time.
+//
+// The zero value is an empty Deque ready to use,
+// `NewDeque`, `NewDequeWithCapacity` and `DequeFromList` create one that already has room for values.
+type Deque[T any] struct {
+	buf    []T
+	head   int
+	length int
+}
+
+// CONSTRUCTION
+
+// Create an empty Deque.
+func NewDeque[T any]() *Deque[T] {
+	return NewDequeWithCapacity[T](8)
+}
+
+// Create an empty Deque with room for the given amount of values before it has to grow.
+func NewDequeWithCapacity[T any](capacity int) *Deque[T] {
+	return &Deque[T]{buf: make([]T, Basics.Max(capacity, 1))}
+}
+
+// Create a Deque holding the values of a list, the first element of the list at the front.
+func DequeFromList[T any](list []T) *Deque[T] {
+	d := NewDequeWithCapacity[T](len(list))
+	d.length = copy(d.buf, list)
+	return d
+}
+
+// METHODS
+
+// Determine the number of values in the Deque.
+func (d *Deque[T]) Len() int {
+	return d.length
+}
+
+// Determine if the Deque is empty.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.length == 0
+}
+
+// Add a value to the front.
+func (d *Deque[T]) PushFront(value T) {
+	d.grow()
+	d.head = d.wrap(d.head - 1)
+	d.buf[d.head] = value
+	d.length++
+}
+
+// Add a value to the back.
+func (d *Deque[T]) PushBack(value T) {
+	d.grow()
+	d.buf[d.wrap(d.head+d.length)] = value
+	d.length++
+}
+
+// Remove the value at the front and return it.
+func (d *Deque[T]) PopFront() Maybe[T] {
+	if d.length == 0 {
+		return Nothing[T]()
+	}
+	value := d.buf[d.head]
+	var zero T
+	d.buf[d.head] = zero
+	d.head = d.wrap(d.head + 1)
+	d.length--
+	return Just(value)
+}
+
+// Remove the value at the back and return it.
+func (d *Deque[T]) PopBack() Maybe[T] {
+	if d.length == 0 {
+		return Nothing[T]()
+	}
+	index := d.wrap(d.head + d.length - 1)
+	value := d.buf[index]
+	var zero T
+	d.buf[index] = zero
+	d.length--
+	return Just(value)
+}
+
+// Get the value at the front without removing it.
+func (d *Deque[T]) PeekFront() Maybe[T] {
+	return d.Get(0)
+}
+
+// Get the value at the back without removing it.
+func (d *Deque[T]) PeekBack() Maybe[T] {
+	return d.Get(d.length - 1)
+}
+
+// Return Just the value at the index, counting from the front, or Nothing if the index is out of range.
+func (d *Deque[T]) Get(index int) Maybe[T] {
+	if index < 0 || index >= d.length {
+		return Nothing[T]()
+	}
+	return Just(d.buf[d.wrap(d.head+index)])
+}
+
+// Set the value at the index, counting from the front.
+// If the index is out of range, the Deque is unaltered and false is returned.
+func (d *Deque[T]) Set(index int, value T) bool {
+	if index < 0 || index >= d.length {
+		return false
+	}
+	d.buf[d.wrap(d.head+index)] = value
+	return true
+}
+
+// Rotate the values by n places, moving the first n values from the front to the back.
+// A negative n moves values from the back to the front instead.
+func (d *Deque[T]) Rotate(n int) {
+	if d.length == 0 {
+		return
+	}
+	n = Basics.ModBy(d.length, n)
+	if d.length == len(d.buf) {
+		// The buffer is full, so rotating is only moving the head.
+		d.head = d.wrap(d.head + n)
+		return
+	}
+	if n <= d.length/2 {
+		for i := 0; i < n; i++ {
+			d.PushBack(d.PopFront().Expect())
+		}
+	} else {
+		for i := 0; i < d.length-n; i++ {
+			d.PushFront(d.PopBack().Expect())
+		}
+	}
+}
+
+// Remove all the values.
+func (d *Deque[T]) Clear() {
+	var zero T
+	for i := range d.buf {
+		d.buf[i] = zero
+	}
+	d.head = 0
+	d.length = 0
+}
+
+// Convert the Deque into a list, from front to back.
+func (d *Deque[T]) ToList() []T {
+	list := make([]T, d.length)
+	n := copy(list, d.buf[d.head:Basics.Min(d.head+d.length, len(d.buf))])
+	copy(list[n:], d.buf[:d.length-n])
+	return list
+}
+
+// INTERNALS
+
+func (d *Deque[T]) wrap(index int) int {
+	return Basics.ModBy(len(d.buf), index)
+}
+
+// Double the buffer when it is full, unwrapping the values to the start of the new buffer.
+// The empty buffer of the zero Deque grows to a single slot.
+func (d *Deque[T]) grow() {
+	if d.length < len(d.buf) {
+		return
+	}
+	buf := make([]T, Basics.Max(2*len(d.buf), 1))
+	copy(buf, d.ToList())
+	d.buf = buf
+	d.head = 0
+}
@@ -0,0 +1,166 @@
+package Queue_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Queue"
+)
+
+func TestZeroDeque(t *testing.T) {
+	var d Queue.Deque[int]
+	if !d.IsEmpty() || d.PopFront().IsJust() || d.PopBack().IsJust() || d.PeekFront().IsJust() || d.PeekBack().IsJust() {
+		t.Fatal("the zero Deque is not empty")
+	}
+	d.PushFront(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	if got := d.ToList(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("ToList = %v", got)
+	}
+	d.Rotate(1)
+	if got := d.ToList(); !reflect.DeepEqual(got, []int{2, 3, 1}) {
+		t.Errorf("ToList after Rotate = %v", got)
+	}
+
+	var back Queue.Deque[int]
+	back.PushBack(1)
+	if back.PeekFront() != Just(1) || back.PeekBack() != Just(1) {
+		t.Error("PushBack onto the zero Deque")
+	}
+}
+
+func TestDequeWrapsAround(t *testing.T) {
+	d := Queue.NewDequeWithCapacity[int](4)
+	for i := 1; i <= 4; i++ {
+		d.PushBack(i)
+	}
+	// Move the head to the middle of the buffer, so the values wrap around its end.
+	d.PopFront()
+	d.PopFront()
+	d.PushBack(5)
+	d.PushBack(6)
+	if got := d.ToList(); !reflect.DeepEqual(got, []int{3, 4, 5, 6}) {
+		t.Errorf("ToList after wrapping = %v", got)
+	}
+	if d.Get(0) != Just(3) || d.Get(3) != Just(6) || d.Get(4).IsJust() || d.Get(-1).IsJust() {
+		t.Error("Get does not follow the wrapped values")
+	}
+	if !d.Set(3, 60) || d.Set(4, 0) || d.Set(-1, 0) || d.PeekBack() != Just(60) {
+		t.Error("Set does not follow the wrapped values")
+	}
+	// Growing a wrapped buffer keeps the order.
+	d.PushBack(7)
+	d.PushFront(2)
+	if got := d.ToList(); !reflect.DeepEqual(got, []int{2, 3, 4, 5, 60, 7}) {
+		t.Errorf("ToList after growing = %v", got)
+	}
+	if d.PopBack() != Just(7) || d.PopFront() != Just(2) || d.Len() != 4 {
+		t.Error("popping after growing")
+	}
+}
+
+func TestRotate(t *testing.T) {
+	cases := []struct {
+		n    int
+		want []int
+	}{
+		{0, []int{1, 2, 3, 4, 5}},
+		{2, []int{3, 4, 5, 1, 2}},
+		{4, []int{5, 1, 2, 3, 4}},
+		{-1, []int{5, 1, 2, 3, 4}},
+		{7, []int{3, 4, 5, 1, 2}},
+	}
+	for _, c := range cases {
+		// A full buffer only moves the head, a roomy one moves the values.
+		for _, capacity := range []int{5, 16} {
+			d := Queue.NewDequeWithCapacity[int](capacity)
+			for i := 1; i <= 5; i++ {
+				d.PushBack(i)
+			}
+			d.Rotate(c.n)
+			if got := d.ToList(); !reflect.DeepEqual(got, c.want) {
+				t.Errorf("Rotate(%d) with capacity %d = %v, want %v", c.n, capacity, got, c.want)
+			}
+		}
+	}
+	empty := Queue.NewDeque[int]()
+	empty.Rotate(3)
+	if !empty.IsEmpty() {
+		t.Error("Rotate of an empty Deque added values")
+	}
+}
+
+func TestDequeFromListAndClear(t *testing.T) {
+	list := []int{1, 2, 3}
+	d := Queue.DequeFromList(list)
+	d.PushFront(0)
+	if got := d.ToList(); !reflect.DeepEqual(got, []int{0, 1, 2, 3}) || !reflect.DeepEqual(list, []int{1, 2, 3}) {
+		t.Errorf("DequeFromList = %v, list = %v", got, list)
+	}
+	d.Clear()
+	if !d.IsEmpty() || len(d.ToList()) != 0 {
+		t.Error("Clear left values behind")
+	}
+	d.PushBack(9)
+	if d.PeekFront() != Just(9) {
+		t.Error("the Deque is unusable after Clear")
+	}
+	if got := Queue.DequeFromList([]int{}).ToList(); len(got) != 0 {
+		t.Errorf("DequeFromList of an empty list = %v", got)
+	}
+}
+
+// Run random operations on a Deque and on a plain list of the values it should hold.
+func TestDequeRandomOperations(t *testing.T) {
+	random := rand.New(rand.NewSource(8))
+	var d Queue.Deque[int]
+	model := []int{}
+	for step := 0; step < 5000; step++ {
+		value := random.Intn(1000)
+		switch random.Intn(5) {
+		case 0:
+			d.PushFront(value)
+			model = append([]int{value}, model...)
+		case 1:
+			d.PushBack(value)
+			model = append(model, value)
+		case 2:
+			got := d.PopFront()
+			if len(model) == 0 {
+				if got.IsJust() {
+					t.Fatalf("step %d: PopFront of an empty Deque = %v", step, got)
+				}
+				continue
+			}
+			if got != Just(model[0]) {
+				t.Fatalf("step %d: PopFront = %v, want %d", step, got, model[0])
+			}
+			model = model[1:]
+		case 3:
+			got := d.PopBack()
+			if len(model) == 0 {
+				if got.IsJust() {
+					t.Fatalf("step %d: PopBack of an empty Deque = %v", step, got)
+				}
+				continue
+			}
+			if got != Just(model[len(model)-1]) {
+				t.Fatalf("step %d: PopBack = %v, want %d", step, got, model[len(model)-1])
+			}
+			model = model[:len(model)-1]
+		case 4:
+			n := random.Intn(10) - 5
+			d.Rotate(n)
+			if len(model) > 0 {
+				n = ((n % len(model)) + len(model)) % len(model)
+				model = append(model[n:], model[:n]...)
+			}
+		}
+		if got := d.ToList(); !reflect.DeepEqual(got, model) {
+			t.Fatalf("step %d: ToList = %v, want %v", step, got, model)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package Queue
+
+import (
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Tuple"
+)
+
+// An immutable first-in first-out queue, implemented as a banker's queue.
+// Values are pushed onto a rear list and popped from a front list,
+// the rear is reversed onto the front whenever it grows longer than the front.
+// Every operation returns a new Queue and leaves the original untouched,
+// pushing and popping take amortized O(1) time.
+//
+// The zero value is an empty Queue.
+type Queue[T any] struct {
+	front    *cell[T]
+	rear     *cell[T]
+	frontLen int
+	rearLen  int
+}
+
+// A persistent singly linked list.
+type cell[T any] struct {
+	value T
+	next  *cell[T]
+}
+
+// CONSTRUCTION
+
+// Create an empty Queue.
+func Empty[T any]() Queue[T] {
+	return Queue[T]{}
+}
+
+// Create a Queue holding the values of a list, the first element of the list at the front.
+func FromList[T any](list []T) Queue[T] {
+	var front *cell[T]
+	for i := len(list) - 1; i >= 0; i-- {
+		front = &cell[T]{list[i], front}
+	}
+	return Queue[T]{front: front, frontLen: len(list)}
+}
+
+// Restore the invariant that the rear is never longer than the front,
+// by appending the reversed rear to the front.
+func balance[T any](q Queue[T]) Queue[T] {
+	if q.rearLen <= q.frontLen {
+		return q
+	}
+	var reversed *cell[T]
+	for c := q.rear; c != nil; c = c.next {
+		reversed = &cell[T]{c.value, reversed}
+	}
+	// The front is shared with other queues, so it has to be copied to append to it.
+	values := make([]T, 0, q.frontLen)
+	for c := q.front; c != nil; c = c.next {
+		values = append(values, c.value)
+	}
+	front := reversed
+	for i := len(values) - 1; i >= 0; i-- {
+		front = &cell[T]{values[i], front}
+	}
+	return Queue[T]{front: front, frontLen: q.frontLen + q.rearLen}
+}
+
+// METHODS
+
+// Determine the number of values in the Queue.
+func (q Queue[T]) Len() int {
+	return q.frontLen + q.rearLen
+}
+
+// Determine if the Queue is empty.
+func (q Queue[T]) IsEmpty() bool {
+	return q.Len() == 0
+}
+
+// Add a value to the back.
+// This functions is IMMUTABLE and produces a new Queue!
+func (q Queue[T]) Push(value T) Queue[T] {
+	q.rear = &cell[T]{value, q.rear}
+	q.rearLen++
+	return balance(q)
+}
+
+// Get the value at the front without removing it.
+func (q Queue[T]) Peek() Maybe[T] {
+	if q.front == nil {
+		return Nothing[T]()
+	}
+	return Just(q.front.value)
+}
+
+// Split off the value at the front.
+// This functions is IMMUTABLE and produces a new Queue!
+func (q Queue[T]) Pop() Maybe[Tuple.Tuple[T, Queue[T]]] {
+	if q.front == nil {
+		return Nothing[Tuple.Tuple[T, Queue[T]]]()
+	}
+	value := q.front.value
+	q.front = q.front.next
+	q.frontLen--
+	return Just(Tuple.Pair(value, balance(q)))
+}
+
+// Convert the Queue into a list, from front to back.
+func (q Queue[T]) ToList() []T {
+	list := make([]T, q.Len())
+	i := 0
+	for c := q.front; c != nil; c = c.next {
+		list[i] = c.value
+		i++
+	}
+	for c, j := q.rear, len(list)-1; c != nil; c, j = c.next, j-1 {
+		list[j] = c.value
+	}
+	return list
+}
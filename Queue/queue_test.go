@@ -0,0 +1,72 @@
+package Queue_test
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Queue"
+)
+
+func TestQueue(t *testing.T) {
+	var zero Queue.Queue[int]
+	if !zero.IsEmpty() || zero.Peek().IsJust() || zero.Pop().IsJust() || len(zero.ToList()) != 0 {
+		t.Fatal("the zero Queue is not empty")
+	}
+	q := Queue.Empty[int]().Push(1).Push(2).Push(3)
+	if q.Len() != 3 || q.Peek() != Just(1) {
+		t.Errorf("Len = %d, Peek = %v", q.Len(), q.Peek())
+	}
+	popped := q.Pop().Expect()
+	if popped.Fst != 1 || !reflect.DeepEqual(popped.Snd.ToList(), []int{2, 3}) {
+		t.Errorf("Pop = %v", popped)
+	}
+	if got := q.ToList(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Pop changed the original Queue to %v", got)
+	}
+}
+
+func TestQueueIsPersistent(t *testing.T) {
+	base := Queue.FromList([]int{1, 2})
+	// Both versions push onto the same base, neither sees the value of the other.
+	a := base.Push(3)
+	b := base.Push(4)
+	rest := a.Pop().Expect().Snd.Push(5)
+	if got := a.ToList(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("a = %v", got)
+	}
+	if got := b.ToList(); !reflect.DeepEqual(got, []int{1, 2, 4}) {
+		t.Errorf("b = %v", got)
+	}
+	if got := rest.ToList(); !reflect.DeepEqual(got, []int{2, 3, 5}) {
+		t.Errorf("rest = %v", got)
+	}
+	if got := base.ToList(); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("base = %v", got)
+	}
+}
+
+func TestQueueFirstInFirstOut(t *testing.T) {
+	q := Queue.Empty[int]()
+	popped := []int{}
+	for i := 0; i < 100; i++ {
+		q = q.Push(i)
+		if i%3 == 2 {
+			pop := q.Pop().Expect()
+			popped = append(popped, pop.Fst)
+			q = pop.Snd
+		}
+	}
+	for pop := q.Pop(); pop.IsJust(); pop = q.Pop() {
+		popped = append(popped, pop.Expect().Fst)
+		q = pop.Expect().Snd
+	}
+	for i, value := range popped {
+		if value != i {
+			t.Fatalf("popped %v", popped)
+		}
+	}
+	if len(popped) != 100 || !q.IsEmpty() {
+		t.Errorf("popped %d values, %d left", len(popped), q.Len())
+	}
+}
@@ -0,0 +1,223 @@
+package Retry
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
+// The amount of attempts made by a new Policy, unless changed with `WithMaxAttempts`.
+const DefaultMaxAttempts = 3
+
+// Describes how often, and how long apart, a failing computation is attempted.
+// A Policy is an immutable value, every `With...` method returns an updated copy.
+//
+// The zero Policy is usable, it behaves like `Fixed(0)`:
+// it makes `DefaultMaxAttempts` attempts without waiting in between.
+type Policy struct {
+	delay       func(attempt int) time.Duration
+	jitter      float64
+	random      func() float64
+	maxAttempts int
+	maxElapsed  time.Duration
+	retryIf     func(err error) bool
+	now         func() time.Time
+	sleep       func(duration time.Duration)
+	onRetry     func(attempt int, err error, delay time.Duration)
+}
+
+// The error of a computation that was given up on.
+// It reports the error of every attempt, in order.
+// `errors.Is` and `errors.As` look through all of them.
+type Error struct {
+	Attempts []error
+}
+
+func (e *Error) Error() string {
+	if len(e.Attempts) == 0 {
+		return "Gave up before the first attempt."
+	}
+	return fmt.Sprintf("Gave up after %d attempts, last error: %s", len(e.Attempts), e.Attempts[len(e.Attempts)-1])
+}
+
+// For working with the `errors`.Is and `errors`.As functionallity.
+func (e *Error) Unwrap() []error {
+	return e.Attempts
+}
+
+// CONSTRUCTION
+
+func newPolicy(delay func(attempt int) time.Duration) Policy {
+	return Policy{delay: delay}.withDefaults()
+}
+
+// Fill in the defaults of the fields that were left unset, which makes the zero Policy usable.
+func (p Policy) withDefaults() Policy {
+	if p.delay == nil {
+		p.delay = func(attempt int) time.Duration { return 0 }
+	}
+	if p.random == nil {
+		p.random = rand.Float64
+	}
+	if p.maxAttempts == 0 {
+		p.maxAttempts = DefaultMaxAttempts
+	}
+	if p.retryIf == nil {
+		p.retryIf = func(err error) bool { return true }
+	}
+	if p.now == nil {
+		p.now = time.Now
+	}
+	if p.sleep == nil {
+		p.sleep = time.Sleep
+	}
+	return p
+}
+
+// Wait the same amount of time between every attempt.
+func Fixed(delay time.Duration) Policy {
+	return newPolicy(func(attempt int) time.Duration {
+		return delay
+	})
+}
+
+// Wait exponentially longer between attempts, starting at initial and multiplying by factor after every attempt.
+// The delay never grows past max.
+func Exponential(initial time.Duration, factor float64, max time.Duration) Policy {
+	return newPolicy(func(attempt int) time.Duration {
+		delay := float64(initial) * math.Pow(factor, float64(attempt-1))
+		if delay > float64(max) {
+			return max
+		}
+		return time.Duration(delay)
+	})
+}
+
+// METHODS
+
+// Randomize every delay by up to the given fraction in either direction,
+// so 0.2 turns a delay of 1s into a delay between 0.8s and 1.2s.
+// This avoids many clients retrying in lockstep.
+func (p Policy) WithJitter(fraction float64) Policy {
+	p.jitter = fraction
+	return p
+}
+
+// Use the given source of random numbers in [0, 1) for the jitter.
+func (p Policy) WithRandom(random func() float64) Policy {
+	p.random = random
+	return p
+}
+
+// Make at most the given amount of attempts.
+// Zero or less means there is no limit on the attempts, use `WithMaxElapsed` to still bound the retrying.
+func (p Policy) WithMaxAttempts(attempts int) Policy {
+	// A zero maxAttempts is left for the zero Policy, which gets the default amount.
+	if attempts <= 0 {
+		attempts = -1
+	}
+	p.maxAttempts = attempts
+	return p
+}
+
+// Stop retrying when waiting for the next attempt would go past the given duration since the first attempt.
+// Zero or less means there is no limit on the elapsed time.
+func (p Policy) WithMaxElapsed(duration time.Duration) Policy {
+	p.maxElapsed = duration
+	return p
+}
+
+// Only retry when the error passes the test, any other error is given up on immediately.
+func (p Policy) RetryIf(testfn func(err error) bool) Policy {
+	p.retryIf = testfn
+	return p
+}
+
+// Only retry when the error is one of the targets, as determined by `errors.Is`.
+func (p Policy) RetryIfIs(targets ...error) Policy {
+	return p.RetryIf(func(err error) bool {
+		for _, target := range targets {
+			if errors.Is(err, target) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Only retry when the error is (or wraps) an error of type E, as determined by `errors.As`.
+func RetryIfAs[E error](p Policy) Policy {
+	return p.RetryIf(func(err error) bool {
+		var target E
+		return errors.As(err, &target)
+	})
+}
+
+// Use the given clock and sleeper instead of the real time,
+// which makes the retry behavior deterministic in tests.
+func (p Policy) WithClock(now func() time.Time, sleep func(duration time.Duration)) Policy {
+	p.now = now
+	p.sleep = sleep
+	return p
+}
+
+// Call the function right before waiting for the next attempt,
+// with the number of the failed attempt (starting at one), its error and the upcoming delay.
+func (p Policy) OnRetry(fn func(attempt int, err error, delay time.Duration)) Policy {
+	p.onRetry = fn
+	return p
+}
+
+// Get the delay to wait after the given failed attempt (starting at one), including the jitter.
+func (p Policy) Delay(attempt int) time.Duration {
+	p = p.withDefaults()
+	delay := p.delay(attempt)
+	if p.jitter > 0 {
+		delay = time.Duration(float64(delay) * (1 + p.jitter*(2*p.random()-1)))
+	}
+	if delay < 0 {
+		return 0
+	}
+	return delay
+}
+
+// RUN
+
+// Attempt the computation until it succeeds or the policy gives up.
+// When the policy gives up, the result is an `Err` with an `*Error`, holding the error of every attempt.
+func Do[T any](p Policy, fn func() MaybeResult.Result[T]) MaybeResult.Result[T] {
+	p = p.withDefaults()
+	start := p.now()
+	attempts := make([]error, 0, DefaultMaxAttempts)
+	for attempt := 1; ; attempt++ {
+		r := fn()
+		if r.IsOk() {
+			return r
+		}
+		err := r.Unwrap()
+		attempts = append(attempts, err)
+		if !p.retryIf(err) || (p.maxAttempts > 0 && attempt >= p.maxAttempts) {
+			break
+		}
+		delay := p.Delay(attempt)
+		if p.maxElapsed > 0 && p.now().Sub(start)+delay > p.maxElapsed {
+			break
+		}
+		if p.onRetry != nil {
+			p.onRetry(attempt, err, delay)
+		}
+		p.sleep(delay)
+	}
+	return MaybeResult.Err[T](&Error{Attempts: attempts})
+}
+
+// Attempt a function that returns (T, error) until it succeeds or the policy gives up.
+func Try[T any](p Policy, fn func() (T, error)) MaybeResult.Result[T] {
+	return Do(p, func() MaybeResult.Result[T] {
+		return MaybeResult.Try(fn)
+	})
+}
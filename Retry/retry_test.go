@@ -0,0 +1,195 @@
+package Retry_test
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Retry"
+)
+
+// A fake clock, time only passes when sleeping.
+type clock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func (c *clock) Sleep(duration time.Duration) {
+	c.sleeps = append(c.sleeps, duration)
+	c.now = c.now.Add(duration)
+}
+
+func withClock(p Retry.Policy) (Retry.Policy, *clock) {
+	c := &clock{now: time.Unix(0, 0)}
+	return p.WithClock(c.Now, c.Sleep), c
+}
+
+// Create a computation that fails the given amount of times before it succeeds.
+func failing(times int, err error) (func() MaybeResult.Result[int], *int) {
+	calls := 0
+	return func() MaybeResult.Result[int] {
+		calls++
+		if calls <= times {
+			return MaybeResult.Err[int](err)
+		}
+		return MaybeResult.Ok(calls)
+	}, &calls
+}
+
+func TestDelays(t *testing.T) {
+	fixed := Retry.Fixed(time.Second)
+	exponential := Retry.Exponential(100*time.Millisecond, 2, time.Second)
+	cases := []struct {
+		name   string
+		policy Retry.Policy
+		want   []time.Duration
+	}{
+		{"fixed", fixed, []time.Duration{time.Second, time.Second, time.Second}},
+		{"exponential", exponential, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second}},
+		{"zero", Retry.Policy{}, []time.Duration{0, 0}},
+	}
+	for _, c := range cases {
+		got := make([]time.Duration, len(c.want))
+		for i := range got {
+			got[i] = c.policy.Delay(i + 1)
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s delays = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestJitter(t *testing.T) {
+	cases := []struct {
+		random float64
+		want   time.Duration
+	}{
+		{0, 800 * time.Millisecond},
+		{0.5, time.Second},
+		{0.75, 1100 * time.Millisecond},
+	}
+	for _, c := range cases {
+		random := c.random
+		p := Retry.Fixed(time.Second).WithJitter(0.2).WithRandom(func() float64 { return random })
+		if got := p.Delay(1); got != c.want {
+			t.Errorf("jittered delay with random %v = %v, want %v", c.random, got, c.want)
+		}
+	}
+	if got := Retry.Fixed(time.Second).WithJitter(3).WithRandom(func() float64 { return 0 }).Delay(1); got != 0 {
+		t.Errorf("a jitter below zero gives %v, want 0", got)
+	}
+}
+
+func TestDoSucceedsAfterFailures(t *testing.T) {
+	p, c := withClock(Retry.Fixed(time.Second))
+	fn, calls := failing(2, io.EOF)
+	r := Retry.Do(p, fn)
+	if r.Expect() != 3 || *calls != 3 {
+		t.Errorf("Do = %v after %d calls, want Ok(3) after 3", r, *calls)
+	}
+	if !reflect.DeepEqual(c.sleeps, []time.Duration{time.Second, time.Second}) {
+		t.Errorf("slept %v, want two seconds", c.sleeps)
+	}
+}
+
+func TestDoGivesUp(t *testing.T) {
+	p, c := withClock(Retry.Fixed(time.Second).WithMaxAttempts(4))
+	fn, calls := failing(10, io.EOF)
+	r := Retry.Do(p, fn)
+	var retryErr *Retry.Error
+	if !errors.As(r, &retryErr) || len(retryErr.Attempts) != 4 || *calls != 4 {
+		t.Fatalf("Do = %v after %d calls, want an *Error with 4 attempts", r, *calls)
+	}
+	if !errors.Is(r, io.EOF) {
+		t.Error("errors.Is does not find the error of the attempts")
+	}
+	if len(c.sleeps) != 3 {
+		t.Errorf("slept %d times, want 3", len(c.sleeps))
+	}
+	if got := retryErr.Error(); got != "Gave up after 4 attempts, last error: EOF" {
+		t.Errorf("Error() = %q", got)
+	}
+}
+
+func TestZeroPolicy(t *testing.T) {
+	fn, calls := failing(10, io.EOF)
+	r := Retry.Do(Retry.Policy{}, fn)
+	if r.IsOk() || *calls != Retry.DefaultMaxAttempts {
+		t.Errorf("the zero Policy made %d attempts, want %d", *calls, Retry.DefaultMaxAttempts)
+	}
+}
+
+func TestRetryIf(t *testing.T) {
+	notFound := &fs.PathError{Op: "open", Path: "x", Err: fs.ErrNotExist}
+	cases := []struct {
+		name   string
+		policy Retry.Policy
+		err    error
+		calls  int
+	}{
+		{"RetryIf rejects", Retry.Fixed(0).RetryIf(func(err error) bool { return false }), io.EOF, 1},
+		{"RetryIfIs matches", Retry.Fixed(0).RetryIfIs(io.ErrUnexpectedEOF, io.EOF), io.EOF, 3},
+		{"RetryIfIs does not match", Retry.Fixed(0).RetryIfIs(io.ErrUnexpectedEOF), io.EOF, 1},
+		{"RetryIfAs matches", Retry.RetryIfAs[*fs.PathError](Retry.Fixed(0)), notFound, 3},
+		{"RetryIfAs does not match", Retry.RetryIfAs[*fs.PathError](Retry.Fixed(0)), io.EOF, 1},
+	}
+	for _, c := range cases {
+		p, _ := withClock(c.policy)
+		fn, calls := failing(10, c.err)
+		Retry.Do(p, fn)
+		if *calls != c.calls {
+			t.Errorf("%s: made %d attempts, want %d", c.name, *calls, c.calls)
+		}
+	}
+}
+
+func TestMaxElapsed(t *testing.T) {
+	p, c := withClock(Retry.Fixed(time.Second).WithMaxAttempts(0).WithMaxElapsed(3500 * time.Millisecond))
+	fn, calls := failing(100, io.EOF)
+	Retry.Do(p, fn)
+	// Attempts at 0s, 1s, 2s and 3s, waiting for one at 4s would go past 3.5s.
+	if *calls != 4 || len(c.sleeps) != 3 {
+		t.Errorf("made %d attempts and slept %d times, want 4 and 3", *calls, len(c.sleeps))
+	}
+}
+
+func TestOnRetry(t *testing.T) {
+	type call struct {
+		attempt int
+		err     error
+		delay   time.Duration
+	}
+	var calls []call
+	p, _ := withClock(Retry.Exponential(time.Second, 2, time.Minute).OnRetry(func(attempt int, err error, delay time.Duration) {
+		calls = append(calls, call{attempt, err, delay})
+	}))
+	fn, _ := failing(2, io.EOF)
+	Retry.Do(p, fn)
+	want := []call{{1, io.EOF, time.Second}, {2, io.EOF, 2 * time.Second}}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("OnRetry calls = %v, want %v", calls, want)
+	}
+}
+
+func TestTry(t *testing.T) {
+	p, _ := withClock(Retry.Fixed(time.Second))
+	calls := 0
+	r := Retry.Try(p, func() (string, error) {
+		calls++
+		if calls == 1 {
+			return "", io.EOF
+		}
+		return "done", nil
+	})
+	if r.Expect() != "done" || calls != 2 {
+		t.Errorf("Try = %v after %d calls", r, calls)
+	}
+}
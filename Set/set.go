@@ -1,8 +1,10 @@
 package Set
 
 import (
+	"github.com/manwitha1000names/gofp/v3/Basics"
 	"github.com/manwitha1000names/gofp/v3/Dict"
 	"github.com/manwitha1000names/gofp/v3/List"
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
 	"github.com/manwitha1000names/gofp/v3/Tuple"
 )
 
@@ -76,6 +78,61 @@ func Diff[T comparable](s Set[T], s1 Set[T]) Set[T] {
 	return Set[T]{Dict.Diff(s.m, s1.m)}
 }
 
+// EXTREMES
+
+// Find the maximum element in a non-empty set.
+func Maximum[T Basics.Ordered](s Set[T]) Maybe[T] {
+	return List.Maximum(ToList(s))
+}
+
+// Find the minimum element in a non-empty set.
+func Minimum[T Basics.Ordered](s Set[T]) Maybe[T] {
+	return List.Minimum(ToList(s))
+}
+
+// Find the element with the largest derived property in a non-empty set.
+// If several elements tie, any one of them may be returned.
+func MaximumBy[T comparable, U Basics.Ordered](mapfn func(value T) U, s Set[T]) Maybe[T] {
+	return List.MaximumBy(mapfn, ToList(s))
+}
+
+// Find the element with the smallest derived property in a non-empty set.
+// If several elements tie, any one of them may be returned.
+func MinimumBy[T comparable, U Basics.Ordered](mapfn func(value T) U, s Set[T]) Maybe[T] {
+	return List.MinimumBy(mapfn, ToList(s))
+}
+
+// Find the largest element according to the comparison function in a non-empty set.
+// If several elements tie, any one of them may be returned.
+func MaximumWith[T comparable](cmpfn func(a, b T) Basics.Order, s Set[T]) Maybe[T] {
+	return List.MaximumWith(cmpfn, ToList(s))
+}
+
+// Find the smallest element according to the comparison function in a non-empty set.
+// If several elements tie, any one of them may be returned.
+func MinimumWith[T comparable](cmpfn func(a, b T) Basics.Order, s Set[T]) Maybe[T] {
+	return List.MinimumWith(cmpfn, ToList(s))
+}
+
+// Find both the minimum and the maximum element of a non-empty set, in a single pass.
+func MinMax[T Basics.Ordered](s Set[T]) Maybe[Tuple.Tuple[T, T]] {
+	return List.MinMax(ToList(s))
+}
+
+// Find all the elements with the largest derived property.
+// This functions is IMMUTABLE and produces a completely new set!
+func MaximumAllBy[T comparable, U Basics.Ordered](mapfn func(value T) U, s Set[T]) Set[T] {
+	list := ToList(s)
+	return FromList(List.Map(func(index int) T { return list[index] }, List.MaximumIndicesBy(mapfn, list)))
+}
+
+// Find all the elements with the smallest derived property.
+// This functions is IMMUTABLE and produces a completely new set!
+func MinimumAllBy[T comparable, U Basics.Ordered](mapfn func(value T) U, s Set[T]) Set[T] {
+	list := ToList(s)
+	return FromList(List.Map(func(index int) T { return list[index] }, List.MinimumIndicesBy(mapfn, list)))
+}
+
 // LISTS
 
 // Convert a set into a list, sorted from lowest to highest.
@@ -0,0 +1,57 @@
+package Set_test
+
+import (
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Set"
+	"github.com/manwitha1000names/gofp/v3/Tuple"
+)
+
+func TestExtremes(t *testing.T) {
+	s := Set.FromList([]int{4, -7, 2, 9})
+	if got := Set.Maximum(s); got != Just(9) {
+		t.Errorf("Maximum = %v", got)
+	}
+	if got := Set.Minimum(s); got != Just(-7) {
+		t.Errorf("Minimum = %v", got)
+	}
+	if got := Set.MinMax(s); got != Just(Tuple.Pair(-7, 9)) {
+		t.Errorf("MinMax = %v", got)
+	}
+	abs := func(n int) int { return Basics.Max(n, -n) }
+	if got := Set.MaximumBy(abs, s); got != Just(9) {
+		t.Errorf("MaximumBy = %v", got)
+	}
+	if got := Set.MinimumBy(abs, s); got != Just(2) {
+		t.Errorf("MinimumBy = %v", got)
+	}
+	byAbs := Basics.Comparing(abs)
+	if got := Set.MaximumWith(byAbs, s); got != Just(9) {
+		t.Errorf("MaximumWith = %v", got)
+	}
+	if got := Set.MinimumWith(byAbs, s); got != Just(2) {
+		t.Errorf("MinimumWith = %v", got)
+	}
+
+	empty := Set.Empty[int]()
+	if Set.Maximum(empty).IsJust() || Set.Minimum(empty).IsJust() || Set.MinMax(empty).IsJust() || Set.MaximumBy(abs, empty).IsJust() || Set.MinimumWith(byAbs, empty).IsJust() {
+		t.Error("the extremes of an empty Set are not Nothing")
+	}
+}
+
+func TestExtremesAll(t *testing.T) {
+	s := Set.FromList([]int{-3, 3, 1, -1, 2})
+	abs := func(n int) int { return Basics.Max(n, -n) }
+	maximums, minimums := Set.MaximumAllBy(abs, s), Set.MinimumAllBy(abs, s)
+	if Set.Size(maximums) != 2 || !Set.Member(-3, maximums) || !Set.Member(3, maximums) {
+		t.Errorf("MaximumAllBy = %v", Set.ToList(maximums))
+	}
+	if Set.Size(minimums) != 2 || !Set.Member(-1, minimums) || !Set.Member(1, minimums) {
+		t.Errorf("MinimumAllBy = %v", Set.ToList(minimums))
+	}
+	if Set.Size(Set.MaximumAllBy(abs, Set.Empty[int]())) != 0 {
+		t.Error("MaximumAllBy of an empty Set is not empty")
+	}
+}
@@ -0,0 +1,238 @@
+// Descriptive statistics over lists of numbers.
+//
+// Every function that is undefined for an empty list returns a `Maybe`.
+// Results are float64, sums of floats are computed with compensated (Kahan) summation.
+package Stats
+
+import (
+	"math"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	"github.com/manwitha1000names/gofp/v3/List"
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Tuple"
+)
+
+// SUMS
+
+// Get the sum of the list elements, the sum of an empty list is zero.
+// Integers can overflow, use `KahanSum` for an accurate sum of floats.
+func Sum[T Basics.Number](list []T) T {
+	var sum T
+	for _, v := range list {
+		sum += v
+	}
+	return sum
+}
+
+// Get the sum of the list elements as a float64, compensating for the rounding errors of floating point addition.
+// Uses the Kahan-Babuska variant, which stays accurate when a value is larger than the running sum.
+func KahanSum[T Basics.Number](list []T) float64 {
+	sum, compensation := 0.0, 0.0
+	for _, v := range list {
+		value := float64(v)
+		next := sum + value
+		if math.Abs(sum) >= math.Abs(value) {
+			compensation += (sum - next) + value
+		} else {
+			compensation += (value - next) + sum
+		}
+		sum = next
+	}
+	return sum + compensation
+}
+
+// Get the running totals of a list, the element at index i is the sum of the elements up to and including index i.
+// This functions is IMMUTABLE and produces a completely new list!
+func CumulativeSum[T Basics.Number](list []T) []T {
+	new_list := make([]T, len(list))
+	var sum T
+	for i, v := range list {
+		sum += v
+		new_list[i] = sum
+	}
+	return new_list
+}
+
+// CENTRAL TENDENCY
+
+// Get the arithmetic mean of a non-empty list.
+func Mean[T Basics.Number](list []T) Maybe[float64] {
+	if len(list) == 0 {
+		return Nothing[float64]()
+	}
+	return Just(KahanSum(list) / float64(len(list)))
+}
+
+// Get the mean of the values, every value counting as much as its weight.
+// Returns Nothing if the lists differ in length, are empty, or the weights sum to zero.
+func WeightedMean[T, W Basics.Number](values []T, weights []W) Maybe[float64] {
+	if len(values) == 0 || len(values) != len(weights) {
+		return Nothing[float64]()
+	}
+	total := KahanSum(weights)
+	if total == 0 {
+		return Nothing[float64]()
+	}
+	return Just(KahanSum(List.Map2(func(value T, weight W) float64 {
+		return float64(value) * float64(weight)
+	}, values, weights)) / total)
+}
+
+// Find the median of a non-empty list.
+// For an even amount of numbers it is the mean of the two middle numbers.
+// Takes O(n) time on average.
+func Median[T Basics.Number](list []T) Maybe[float64] {
+	return List.Median(list)
+}
+
+// Find the value that appears the most often in a non-empty list.
+// When several values appear equally often, the one that appears first in the list wins.
+func Mode[T Basics.Number](list []T) Maybe[T] {
+	if len(list) == 0 {
+		return Nothing[T]()
+	}
+	counts := make(map[T]int, len(list))
+	for _, v := range list {
+		counts[v]++
+	}
+	mode := list[0]
+	for _, v := range list {
+		if counts[v] > counts[mode] {
+			mode = v
+		}
+	}
+	return Just(mode)
+}
+
+// Find the p-th percentile of a non-empty list, where p is between 0 and 100.
+// Values between two numbers are linearly interpolated, so Percentile(50, list) is the median.
+func Percentile[T Basics.Number](p float64, list []T) Maybe[float64] {
+	return List.Quantile(p/100, list)
+}
+
+// SPREAD
+
+// Get the mean and the sum of squared differences from the mean, with Welford's algorithm,
+// which does not lose precision when the values are large compared to their spread.
+func welford[T Basics.Number](list []T) (float64, float64) {
+	mean, squares := 0.0, 0.0
+	for i, v := range list {
+		value := float64(v)
+		delta := value - mean
+		mean += delta / float64(i+1)
+		squares += delta * (value - mean)
+	}
+	return mean, squares
+}
+
+// Get the population variance of a non-empty list, the mean of the squared differences from the mean.
+func Variance[T Basics.Number](list []T) Maybe[float64] {
+	if len(list) == 0 {
+		return Nothing[float64]()
+	}
+	_, squares := welford(list)
+	return Just(squares / float64(len(list)))
+}
+
+// Get the sample variance of a list with at least two elements,
+// which divides by n - 1 to estimate the variance of the population the sample was taken from.
+func SampleVariance[T Basics.Number](list []T) Maybe[float64] {
+	if len(list) < 2 {
+		return Nothing[float64]()
+	}
+	_, squares := welford(list)
+	return Just(squares / float64(len(list)-1))
+}
+
+// Get the population standard deviation of a non-empty list, the square root of the `Variance`.
+func StdDev[T Basics.Number](list []T) Maybe[float64] {
+	return Variance(list).Map(math.Sqrt)
+}
+
+// Get the sample standard deviation of a list with at least two elements, the square root of the `SampleVariance`.
+func SampleStdDev[T Basics.Number](list []T) Maybe[float64] {
+	return SampleVariance(list).Map(math.Sqrt)
+}
+
+// Rescale the values linearly to lie between 0 and 1, the minimum becomes 0 and the maximum becomes 1.
+// If all the values are equal, they all become 0.
+// This functions is IMMUTABLE and produces a completely new list!
+func Normalize[T Basics.Number](list []T) []float64 {
+	if len(list) == 0 {
+		return []float64{}
+	}
+	low, high := float64(List.Minimum(list).Expect()), float64(List.Maximum(list).Expect())
+	return List.Map(func(value T) float64 {
+		if high == low {
+			return 0
+		}
+		return (float64(value) - low) / (high - low)
+	}, list)
+}
+
+// BINNING
+
+// A bin of a histogram, counting the values from Low up to High.
+// Every bin includes its Low bound, only the last bin includes its High bound as well.
+type Bin struct {
+	Low   float64
+	High  float64
+	Count int
+}
+
+// Count the values of a list in the given amount of equally wide bins, spanning from the minimum to the maximum.
+// If all the values are equal, there is a single bin holding all of them.
+// NaN and infinite values do not fit in any bin, they are skipped.
+// A list without finite values results in no bins.
+func Histogram[T Basics.Number](bins int, list []T) []Bin {
+	values := List.FilterMap(func(v T) Maybe[float64] {
+		value := float64(v)
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return Nothing[float64]()
+		}
+		return Just(value)
+	}, list)
+	if len(values) == 0 {
+		return []Bin{}
+	}
+	low, high := List.Minimum(values).Expect(), List.Maximum(values).Expect()
+	bins = Basics.Max(bins, 1)
+	// Halving first keeps high - low from overflowing for values near the limits of float64.
+	half_width := (high/2 - low/2) / float64(bins)
+	if !(half_width > 0) {
+		return []Bin{{low, high, len(values)}}
+	}
+	histogram := make([]Bin, bins)
+	for i := range histogram {
+		histogram[i] = Bin{Low: low + float64(2*i)*half_width, High: low + float64(2*i+2)*half_width}
+	}
+	histogram[bins-1].High = high
+	for _, value := range values {
+		index := Basics.Clamp(0, int((value/2-low/2)/half_width), bins-1)
+		histogram[index].Count++
+	}
+	return histogram
+}
+
+// Count the values of a list in the bins between consecutive edges, which must be sorted from lowest to highest.
+// n edges make n - 1 bins, values outside of the edges and NaN values are not counted.
+func HistogramWithEdges[T Basics.Number](edges []float64, list []T) []Bin {
+	histogram := List.Map(func(pair Tuple.Tuple[float64, float64]) Bin {
+		return Bin{Low: pair.Fst, High: pair.Snd}
+	}, List.Pairwise(edges))
+	for _, v := range list {
+		value := float64(v)
+		if math.IsNaN(value) {
+			continue
+		}
+		index := List.UpperBound(value, edges) - 1
+		if index == len(histogram) && len(edges) > 0 && value == edges[len(edges)-1] {
+			index--
+		}
+		if index >= 0 && index < len(histogram) {
+			histogram[index].Count++
+		}
+	}
+	return histogram
+}
@@ -0,0 +1,164 @@
+package Stats_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Stats"
+)
+
+// Fail the test when the result is not Just a value within a relative tolerance of want.
+func assertClose(t *testing.T, name string, got Maybe[float64], want float64) {
+	t.Helper()
+	if got.IsNothing() {
+		t.Errorf("%s = Nothing, want %v", name, want)
+		return
+	}
+	if value := got.Expect(); math.Abs(value-want) > 1e-9*math.Max(1, math.Abs(want)) {
+		t.Errorf("%s = %v, want %v", name, value, want)
+	}
+}
+
+func TestSums(t *testing.T) {
+	if got := Stats.Sum([]int{1, 2, 3}); got != 6 {
+		t.Errorf("Sum = %d", got)
+	}
+	if got := Stats.Sum([]float64{}); got != 0 {
+		t.Errorf("Sum of an empty list = %v", got)
+	}
+	// Plain summation loses the small values next to the large ones, and ends up at 0.
+	values := []float64{1e100, 1, -1e100, 1}
+	if got := Stats.KahanSum(values); got != 2 {
+		t.Errorf("KahanSum = %v, want 2", got)
+	}
+	tenths := make([]float64, 1000)
+	for i := range tenths {
+		tenths[i] = 0.1
+	}
+	if got := Stats.KahanSum(tenths); got != 100 {
+		t.Errorf("KahanSum of a thousand tenths = %v, want 100", got)
+	}
+	if got := Stats.CumulativeSum([]int{1, 2, 3, -1}); !reflect.DeepEqual(got, []int{1, 3, 6, 5}) {
+		t.Errorf("CumulativeSum = %v", got)
+	}
+	if got := Stats.CumulativeSum([]int{}); len(got) != 0 {
+		t.Errorf("CumulativeSum of an empty list = %v", got)
+	}
+}
+
+func TestCentralTendency(t *testing.T) {
+	assertClose(t, "Mean", Stats.Mean([]int{1, 2, 3, 4}), 2.5)
+	assertClose(t, "WeightedMean", Stats.WeightedMean([]float64{1, 2, 3}, []int{3, 0, 1}), 1.5)
+	assertClose(t, "Median", Stats.Median([]int{5, 1, 3}), 3)
+	assertClose(t, "Percentile 0", Stats.Percentile(0, []int{5, 1, 3}), 1)
+	assertClose(t, "Percentile 75", Stats.Percentile(75, []int{1, 2, 3, 4, 5}), 4)
+	assertClose(t, "Percentile 90", Stats.Percentile(90, []int{10, 20}), 19)
+
+	nothings := map[string]Maybe[float64]{
+		"Mean of an empty list":                Stats.Mean([]int{}),
+		"WeightedMean of different lengths":    Stats.WeightedMean([]int{1, 2}, []int{1}),
+		"WeightedMean of empty lists":          Stats.WeightedMean([]int{}, []int{}),
+		"WeightedMean of weights summing to 0": Stats.WeightedMean([]int{1, 2}, []int{1, -1}),
+		"Median of an empty list":              Stats.Median([]int{}),
+		"Percentile above 100":                 Stats.Percentile(101, []int{1}),
+		"Percentile below 0":                   Stats.Percentile(-1, []int{1}),
+		"Percentile of an empty list":          Stats.Percentile(50, []int{}),
+	}
+	for name, got := range nothings {
+		if got.IsJust() {
+			t.Errorf("%s = %v, want Nothing", name, got)
+		}
+	}
+
+	if got := Stats.Mode([]int{3, 1, 1, 3, 2}); got != Just(3) {
+		t.Errorf("Mode with a tie = %v, want the first value Just(3)", got)
+	}
+	if got := Stats.Mode([]int{3, 1, 1}); got != Just(1) {
+		t.Errorf("Mode = %v", got)
+	}
+	if got := Stats.Mode([]int{}); got.IsJust() {
+		t.Errorf("Mode of an empty list = %v", got)
+	}
+}
+
+func TestSpread(t *testing.T) {
+	list := []int{2, 4, 4, 4, 5, 5, 7, 9}
+	assertClose(t, "Variance", Stats.Variance(list), 4)
+	assertClose(t, "StdDev", Stats.StdDev(list), 2)
+	assertClose(t, "SampleVariance", Stats.SampleVariance(list), 32.0/7)
+	assertClose(t, "SampleStdDev", Stats.SampleStdDev(list), math.Sqrt(32.0/7))
+	assertClose(t, "Variance of a single value", Stats.Variance([]int{7}), 0)
+
+	// A naive sum of squares cancels catastrophically for large values with a small spread.
+	shifted := []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}
+	assertClose(t, "Variance of large values", Stats.Variance(shifted), 22.5)
+
+	if Stats.Variance([]int{}).IsJust() || Stats.StdDev([]int{}).IsJust() {
+		t.Error("the Variance of an empty list is not Nothing")
+	}
+	if Stats.SampleVariance([]int{1}).IsJust() || Stats.SampleStdDev([]int{1}).IsJust() {
+		t.Error("the SampleVariance of a single value is not Nothing")
+	}
+
+	if got := Stats.Normalize([]int{10, 20, 15}); !reflect.DeepEqual(got, []float64{0, 1, 0.5}) {
+		t.Errorf("Normalize = %v", got)
+	}
+	if got := Stats.Normalize([]int{3, 3}); !reflect.DeepEqual(got, []float64{0, 0}) {
+		t.Errorf("Normalize of equal values = %v", got)
+	}
+	if got := Stats.Normalize([]int{}); len(got) != 0 {
+		t.Errorf("Normalize of an empty list = %v", got)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	got := Stats.Histogram(4, []float64{0, 1, 2, 3, 4, 5, 6, 7, 8})
+	want := []Stats.Bin{{0, 2, 2}, {2, 4, 2}, {4, 6, 2}, {6, 8, 3}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Histogram = %v, want %v", got, want)
+	}
+	if got := Stats.Histogram(3, []int{5, 5, 5}); !reflect.DeepEqual(got, []Stats.Bin{{5, 5, 3}}) {
+		t.Errorf("Histogram of equal values = %v", got)
+	}
+	if got := Stats.Histogram(0, []int{1, 2}); !reflect.DeepEqual(got, []Stats.Bin{{1, 2, 2}}) {
+		t.Errorf("Histogram of zero bins = %v", got)
+	}
+	if got := Stats.Histogram(3, []int{}); len(got) != 0 {
+		t.Errorf("Histogram of an empty list = %v", got)
+	}
+}
+
+func TestHistogramSkipsNaNAndInf(t *testing.T) {
+	list := []float64{math.NaN(), 0, math.Inf(1), 10, math.Inf(-1), 5}
+	got := Stats.Histogram(2, list)
+	want := []Stats.Bin{{0, 5, 1}, {5, 10, 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Histogram = %v, want %v", got, want)
+	}
+	if got := Stats.Histogram(2, []float64{math.NaN(), math.Inf(1)}); len(got) != 0 {
+		t.Errorf("Histogram without finite values = %v", got)
+	}
+
+	// The span between the extremes of float64 overflows to +Inf when computed naively.
+	extremes := Stats.Histogram(2, []float64{-math.MaxFloat64, 0, math.MaxFloat64})
+	if len(extremes) != 2 || extremes[0].Count+extremes[1].Count != 3 || math.IsInf(extremes[0].High, 0) || math.IsNaN(extremes[0].High) {
+		t.Errorf("Histogram of the extremes = %v", extremes)
+	}
+}
+
+func TestHistogramWithEdges(t *testing.T) {
+	list := []float64{-1, 0, 0.5, 1, 2, 3, 3, 4, math.NaN()}
+	got := Stats.HistogramWithEdges([]float64{0, 1, 3}, list)
+	want := []Stats.Bin{{0, 1, 2}, {1, 3, 4}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("HistogramWithEdges = %v, want %v", got, want)
+	}
+	if got := Stats.HistogramWithEdges([]float64{1}, list); len(got) != 0 {
+		t.Errorf("HistogramWithEdges of a single edge = %v", got)
+	}
+	if got := Stats.HistogramWithEdges([]float64{}, list); len(got) != 0 {
+		t.Errorf("HistogramWithEdges without edges = %v", got)
+	}
+}
@@ -0,0 +1,415 @@
+// Streaming pipelines built on channels.
+//
+// Every stage reads from an input channel and returns an output channel that is closed
+// once the input is exhausted or the context is done.
+// Channels are unbuffered, so a slow consumer slows down the whole pipeline (backpressure).
+// Cancel the context to stop a pipeline early, this releases every goroutine of every stage.
+package Stream
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
+// Send a value, giving up when the context is done.
+func send[T any](ctx context.Context, out chan<- T, value T) bool {
+	select {
+	case out <- value:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Receive a value, giving up when the context is done or the channel is closed.
+func receive[T any](ctx context.Context, in <-chan T) (T, bool) {
+	select {
+	case value, ok := <-in:
+		return value, ok
+	case <-ctx.Done():
+		var zero T
+		return zero, false
+	}
+}
+
+// CREATE
+
+// Stream the elements of a list, in order.
+func FromList[T any](ctx context.Context, list []T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for _, value := range list {
+			if !send(ctx, out, value) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Stream the values produced by the function until it returns Nothing.
+func Generate[T any](ctx context.Context, genfn func() MaybeResult.Maybe[T]) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			next := genfn()
+			if next.IsNothing() || !send(ctx, out, next.Expect()) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// CONSUME
+
+// Collect every value of the stream into a list, in order.
+// Stops early when the context is done.
+func ToList[T any](ctx context.Context, in <-chan T) []T {
+	list := make([]T, 0, 8)
+	for {
+		value, ok := receive(ctx, in)
+		if !ok {
+			return list
+		}
+		list = append(list, value)
+	}
+}
+
+// Reduce a stream from the left.
+// Stops early when the context is done.
+func Foldl[T, U any](ctx context.Context, reducefn func(value T, accumulator U) U, acc U, in <-chan T) U {
+	for {
+		value, ok := receive(ctx, in)
+		if !ok {
+			return acc
+		}
+		acc = reducefn(value, acc)
+	}
+}
+
+// Collect the values of a stream of results into a list, stopping at the first `Err`.
+// If the context is done before the stream is exhausted, the result is `Err(ctx.Err())`.
+// Cancel the context after an `Err` to release the stages that are still running.
+func Collect[T any](ctx context.Context, in <-chan MaybeResult.Result[T]) MaybeResult.Result[[]T] {
+	list := make([]T, 0, 8)
+	for {
+		r, ok := receive(ctx, in)
+		if !ok {
+			if ctx.Err() != nil {
+				return MaybeResult.Err[[]T](ctx.Err())
+			}
+			return MaybeResult.Ok(list)
+		}
+		if r.IsErr() {
+			return MaybeResult.Err[[]T](r.Unwrap())
+		}
+		list = append(list, r.Expect())
+	}
+}
+
+// TRANSFORM
+
+// Apply a function to every value of a stream.
+func Map[T, U any](ctx context.Context, mapfn func(value T) U, in <-chan T) <-chan U {
+	out := make(chan U)
+	go func() {
+		defer close(out)
+		for {
+			value, ok := receive(ctx, in)
+			if !ok || !send(ctx, out, mapfn(value)) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Apply a fallible function to every value of a stream, streaming the results.
+// Use `Collect` to stop at the first `Err`.
+func TryMap[T, U any](ctx context.Context, mapfn func(value T) MaybeResult.Result[U], in <-chan T) <-chan MaybeResult.Result[U] {
+	return Map(ctx, mapfn, in)
+}
+
+// Keep values that satisfy the test.
+func Filter[T any](ctx context.Context, testfn func(value T) bool, in <-chan T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			value, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+			if testfn(value) && !send(ctx, out, value) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Filter out certain values.
+func FilterMap[T, U any](ctx context.Context, testmapfn func(value T) MaybeResult.Maybe[U], in <-chan T) <-chan U {
+	out := make(chan U)
+	go func() {
+		defer close(out)
+		for {
+			value, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+			res := testmapfn(value)
+			if res.IsJust() && !send(ctx, out, res.Expect()) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Apply a function to every value of a stream, running at most `workers` calls at the same time.
+// Ordering IS preserved!
+func Map_par[T, U any](ctx context.Context, workers int, mapfn func(value T) U, in <-chan T) <-chan U {
+	out := make(chan U)
+	// Every in-flight value owns a slot in pending, in input order.
+	// The reader holds one slot while it waits, hence workers - 1.
+	pending := make(chan chan U, Basics.Max(workers, 1)-1)
+	go func() {
+		defer close(pending)
+		for {
+			value, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+			result := make(chan U, 1)
+			if !send(ctx, pending, result) {
+				return
+			}
+			go func(value T) {
+				result <- mapfn(value)
+			}(value)
+		}
+	}()
+	go func() {
+		defer close(out)
+		for result := range pending {
+			value, ok := receive(ctx, result)
+			if !ok || !send(ctx, out, value) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// GROUP
+
+// Group consecutive values into lists of the given size.
+// The last batch holds the remaining values, and may be smaller.
+func Batch[T any](ctx context.Context, size int, in <-chan T) <-chan []T {
+	return BatchTimeout(ctx, size, 0, in)
+}
+
+// Group consecutive values into lists of the given size,
+// emitting a smaller batch when maxWait has passed since the first value of the batch arrived.
+// A maxWait of zero or less waits for full batches.
+// The last batch holds the remaining values, and may be smaller.
+func BatchTimeout[T any](ctx context.Context, size int, maxWait time.Duration, in <-chan T) <-chan []T {
+	size = Basics.Max(size, 1)
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+		batch := make([]T, 0, size)
+		var deadline <-chan time.Time
+		var timer *time.Timer
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, deadline = nil, nil
+			}
+			if len(batch) == 0 {
+				return true
+			}
+			full := batch
+			batch = make([]T, 0, size)
+			return send(ctx, out, full)
+		}
+		for {
+			select {
+			case value, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				if len(batch) == 0 && maxWait > 0 {
+					timer = time.NewTimer(maxWait)
+					deadline = timer.C
+				}
+				batch = append(batch, value)
+				if len(batch) == size && !flush() {
+					return
+				}
+			case <-deadline:
+				timer, deadline = nil, nil
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Emit sliding windows of the given size, starting a new window every `step` values.
+// Incomplete windows at the end of the stream are not emitted.
+func Window[T any](ctx context.Context, size int, step int, in <-chan T) <-chan []T {
+	size = Basics.Max(size, 1)
+	step = Basics.Max(step, 1)
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+		window := make([]T, 0, size)
+		skip := 0
+		for {
+			value, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+			if skip > 0 {
+				skip--
+				continue
+			}
+			window = append(window, value)
+			if len(window) < size {
+				continue
+			}
+			full := make([]T, size)
+			copy(full, window)
+			if !send(ctx, out, full) {
+				return
+			}
+			if step >= size {
+				skip = step - size
+				window = window[:0]
+			} else {
+				window = append(window[:0], window[step:]...)
+			}
+		}
+	}()
+	return out
+}
+
+// COMBINE
+
+// Distribute the values over n streams, every value goes to exactly one of them,
+// whichever is ready first. Useful for spreading work over several workers.
+func FanOut[T any](ctx context.Context, n int, in <-chan T) []<-chan T {
+	n = Basics.Max(n, 1)
+	outs := make([]<-chan T, n)
+	for i := range outs {
+		out := make(chan T)
+		outs[i] = out
+		go func() {
+			defer close(out)
+			for {
+				value, ok := receive(ctx, in)
+				if !ok || !send(ctx, out, value) {
+					return
+				}
+			}
+		}()
+	}
+	return outs
+}
+
+// Copy every value to each of the n streams.
+// The slowest stream determines the pace of all of them.
+func Broadcast[T any](ctx context.Context, n int, in <-chan T) []<-chan T {
+	n = Basics.Max(n, 1)
+	chans := make([]chan T, n)
+	outs := make([]<-chan T, n)
+	for i := range chans {
+		chans[i] = make(chan T)
+		outs[i] = chans[i]
+	}
+	go func() {
+		defer func() {
+			for _, ch := range chans {
+				close(ch)
+			}
+		}()
+		for {
+			value, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+			for _, ch := range chans {
+				if !send(ctx, ch, value) {
+					return
+				}
+			}
+		}
+	}()
+	return outs
+}
+
+// Merge many streams into one, in no particular order.
+// The result is closed once all the streams are closed.
+func FanIn[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	wg.Add(len(ins))
+	for _, in := range ins {
+		go func(in <-chan T) {
+			defer wg.Done()
+			for {
+				value, ok := receive(ctx, in)
+				if !ok || !send(ctx, out, value) {
+					return
+				}
+			}
+		}(in)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// Merge many sorted streams into one sorted stream, using the comparison function.
+// When values compare equal, the value from the earlier stream comes first.
+func Merge[T any](ctx context.Context, cmpfn func(a, b T) Basics.Order, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		heads := make([]MaybeResult.Maybe[T], len(ins))
+		for i, in := range ins {
+			value, ok := receive(ctx, in)
+			heads[i] = MaybeResult.TupleToMaybe(value, ok)
+		}
+		for {
+			smallest := -1
+			for i, head := range heads {
+				if head.IsJust() && (smallest < 0 || cmpfn(head.Expect(), heads[smallest].Expect()) < 0) {
+					smallest = i
+				}
+			}
+			if smallest < 0 || !send(ctx, out, heads[smallest].Expect()) {
+				return
+			}
+			value, ok := receive(ctx, ins[smallest])
+			heads[smallest] = MaybeResult.TupleToMaybe(value, ok)
+		}
+	}()
+	return out
+}
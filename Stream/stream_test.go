@@ -0,0 +1,270 @@
+package Stream_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Stream"
+)
+
+func numbers(n int) []int {
+	list := make([]int, n)
+	for i := range list {
+		list[i] = i + 1
+	}
+	return list
+}
+
+// Create a context that is cancelled when the test is over.
+func testContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return ctx
+}
+
+// Collect the values of many streams concurrently, one list per stream.
+func drainAll[T any](ctx context.Context, ins []<-chan T) [][]T {
+	lists := make([][]T, len(ins))
+	var wg sync.WaitGroup
+	for i, in := range ins {
+		wg.Add(1)
+		go func(i int, in <-chan T) {
+			defer wg.Done()
+			lists[i] = Stream.ToList(ctx, in)
+		}(i, in)
+	}
+	wg.Wait()
+	return lists
+}
+
+func TestFromListToList(t *testing.T) {
+	ctx := testContext(t)
+	if got := Stream.ToList(ctx, Stream.FromList(ctx, numbers(5))); !reflect.DeepEqual(got, numbers(5)) {
+		t.Errorf("round trip = %v", got)
+	}
+	if got := Stream.ToList(ctx, Stream.FromList(ctx, []int{})); len(got) != 0 {
+		t.Errorf("round trip of an empty list = %v", got)
+	}
+}
+
+func TestGenerateAndFoldl(t *testing.T) {
+	ctx := testContext(t)
+	n := 0
+	counter := Stream.Generate(ctx, func() MaybeResult.Maybe[int] {
+		n++
+		if n > 4 {
+			return MaybeResult.Nothing[int]()
+		}
+		return MaybeResult.Just(n)
+	})
+	if sum := Stream.Foldl(ctx, Basics.Add[int], 0, counter); sum != 10 {
+		t.Errorf("Foldl = %d, want 10", sum)
+	}
+}
+
+func TestTransforms(t *testing.T) {
+	ctx := testContext(t)
+	isEven := func(n int) bool { return n%2 == 0 }
+	doubled := Stream.Map(ctx, func(n int) int { return n * 2 }, Stream.FromList(ctx, numbers(3)))
+	if got := Stream.ToList(ctx, doubled); !reflect.DeepEqual(got, []int{2, 4, 6}) {
+		t.Errorf("Map = %v", got)
+	}
+	evens := Stream.Filter(ctx, isEven, Stream.FromList(ctx, numbers(6)))
+	if got := Stream.ToList(ctx, evens); !reflect.DeepEqual(got, []int{2, 4, 6}) {
+		t.Errorf("Filter = %v", got)
+	}
+	halves := Stream.FilterMap(ctx, func(n int) MaybeResult.Maybe[int] {
+		if !isEven(n) {
+			return MaybeResult.Nothing[int]()
+		}
+		return MaybeResult.Just(n / 2)
+	}, Stream.FromList(ctx, numbers(6)))
+	if got := Stream.ToList(ctx, halves); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("FilterMap = %v", got)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	ctx := testContext(t)
+	parsed := Stream.TryMap(ctx, func(s string) MaybeResult.Result[int] {
+		return MaybeResult.ErrToResult(strconv.Atoi(s))
+	}, Stream.FromList(ctx, []string{"1", "2", "3"}))
+	if r := Stream.Collect(ctx, parsed); !reflect.DeepEqual(r.Expect(), []int{1, 2, 3}) {
+		t.Errorf("Collect = %v", r)
+	}
+
+	results := Stream.FromList(ctx, []MaybeResult.Result[int]{MaybeResult.Ok(1), MaybeResult.Err[int](io.EOF), MaybeResult.Ok(3)})
+	if r := Stream.Collect(ctx, results); r.Unwrap() != io.EOF {
+		t.Errorf("Collect = %v, want the first Err", r)
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if r := Stream.Collect(cancelled, make(chan MaybeResult.Result[int])); !errors.Is(r, context.Canceled) {
+		t.Errorf("Collect with a cancelled context = %v", r)
+	}
+}
+
+func TestMapParKeepsTheOrderAndBoundsTheWorkers(t *testing.T) {
+	ctx := testContext(t)
+	const workers = 4
+	var running, maxRunning atomic.Int32
+	random := rand.New(rand.NewSource(1))
+	delays := make([]time.Duration, 100)
+	for i := range delays {
+		delays[i] = time.Duration(random.Intn(200)) * time.Microsecond
+	}
+	out := Stream.Map_par(ctx, workers, func(n int) int {
+		now := running.Add(1)
+		for {
+			seen := maxRunning.Load()
+			if now <= seen || maxRunning.CompareAndSwap(seen, now) {
+				break
+			}
+		}
+		time.Sleep(delays[n-1])
+		running.Add(-1)
+		return n * n
+	}, Stream.FromList(ctx, numbers(100)))
+	got := Stream.ToList(ctx, out)
+	want := make([]int, 100)
+	for i := range want {
+		want[i] = (i + 1) * (i + 1)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Map_par = %v, want the squares in order", got)
+	}
+	if maxRunning.Load() > workers {
+		t.Errorf("%d calls ran at the same time, want at most %d", maxRunning.Load(), workers)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	ctx := testContext(t)
+	got := Stream.ToList(ctx, Stream.Batch(ctx, 3, Stream.FromList(ctx, numbers(7))))
+	want := [][]int{{1, 2, 3}, {4, 5, 6}, {7}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Batch = %v, want %v", got, want)
+	}
+}
+
+func TestBatchTimeoutEmitsPartialBatches(t *testing.T) {
+	ctx := testContext(t)
+	in := make(chan int)
+	out := Stream.BatchTimeout(ctx, 10, time.Millisecond, in)
+	in <- 1
+	in <- 2
+	select {
+	case batch := <-out:
+		if !reflect.DeepEqual(batch, []int{1, 2}) {
+			t.Errorf("first batch = %v, want [1 2]", batch)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the partial batch was not emitted")
+	}
+	in <- 3
+	close(in)
+	if rest := Stream.ToList(ctx, out); !reflect.DeepEqual(rest, [][]int{{3}}) {
+		t.Errorf("remaining batches = %v, want [[3]]", rest)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	ctx := testContext(t)
+	cases := []struct {
+		n, size, step int
+		want          [][]int
+	}{
+		{5, 3, 1, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}},
+		{5, 3, 2, [][]int{{1, 2, 3}, {3, 4, 5}}},
+		{8, 2, 4, [][]int{{1, 2}, {5, 6}}},
+		{2, 3, 1, nil},
+	}
+	for _, c := range cases {
+		got := Stream.ToList(ctx, Stream.Window(ctx, c.size, c.step, Stream.FromList(ctx, numbers(c.n))))
+		if len(got) != len(c.want) || (len(got) > 0 && !reflect.DeepEqual(got, c.want)) {
+			t.Errorf("Window(%d, %d) of %d values = %v, want %v", c.size, c.step, c.n, got, c.want)
+		}
+	}
+}
+
+func TestFanOutFanIn(t *testing.T) {
+	ctx := testContext(t)
+	outs := Stream.FanOut(ctx, 3, Stream.FromList(ctx, numbers(50)))
+	var all []int
+	for _, list := range drainAll(ctx, outs) {
+		all = append(all, list...)
+	}
+	sort.Ints(all)
+	if !reflect.DeepEqual(all, numbers(50)) {
+		t.Errorf("FanOut lost or duplicated values: %v", all)
+	}
+
+	merged := Stream.ToList(ctx, Stream.FanIn(ctx, Stream.FromList(ctx, []int{1, 2}), Stream.FromList(ctx, []int{3}), Stream.FromList(ctx, []int{})))
+	sort.Ints(merged)
+	if !reflect.DeepEqual(merged, []int{1, 2, 3}) {
+		t.Errorf("FanIn = %v", merged)
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	ctx := testContext(t)
+	for i, list := range drainAll(ctx, Stream.Broadcast(ctx, 3, Stream.FromList(ctx, numbers(10)))) {
+		if !reflect.DeepEqual(list, numbers(10)) {
+			t.Errorf("Broadcast stream %d = %v", i, list)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	ctx := testContext(t)
+	type item struct {
+		key    int
+		stream string
+	}
+	byKey := func(a, b item) Basics.Order { return Basics.Compare(a.key, b.key) }
+	a := Stream.FromList(ctx, []item{{1, "a"}, {3, "a"}, {5, "a"}})
+	b := Stream.FromList(ctx, []item{{1, "b"}, {2, "b"}, {6, "b"}})
+	c := Stream.FromList(ctx, []item{})
+	got := Stream.ToList(ctx, Stream.Merge(ctx, byKey, a, b, c))
+	want := []item{{1, "a"}, {1, "b"}, {2, "b"}, {3, "a"}, {5, "a"}, {6, "b"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge = %v, want %v", got, want)
+	}
+}
+
+func TestCancelReleasesThePipeline(t *testing.T) {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	endless := Stream.Generate(ctx, func() MaybeResult.Maybe[int] { return MaybeResult.Just(1) })
+	pipeline := Stream.Batch(ctx, 2, Stream.Map_par(ctx, 4, func(n int) int { return n }, Stream.Filter(ctx, func(int) bool { return true }, endless)))
+	<-pipeline
+	cancel()
+	deadline := time.After(5 * time.Second)
+	for range pipeline {
+		select {
+		case <-deadline:
+			t.Fatal("the pipeline kept running after cancelling")
+		default:
+		}
+	}
+	for runtime.NumGoroutine() > before {
+		select {
+		case <-deadline:
+			t.Fatalf("%d goroutines are still running after cancelling", runtime.NumGoroutine()-before)
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
@@ -328,3 +328,35 @@ func Any(testfn func(c rune) bool, s string) bool {
 func All(testfn func(c rune) bool, s string) bool {
 	return List.All(testfn, ToList(s))
 }
+
+// DIFF
+
+// Find a shortest edit script that turns the first string into the second, character by character.
+func Diff(s string, s1 string) []List.Edit[rune] {
+	return List.Diff(ToList(s), ToList(s1))
+}
+
+// Find a shortest edit script that turns the first string into the second, line by line.
+func DiffLines(s string, s1 string) []List.Edit[string] {
+	return List.Diff(Lines(s), Lines(s1))
+}
+
+// Apply an edit script made by `Diff` to a string.
+// Fails if the script does not match the string.
+func Patch(edits []List.Edit[rune], s string) Result[string] {
+	r := List.Patch(edits, ToList(s))
+	if r.IsErr() {
+		return Err[string](r.Unwrap())
+	}
+	return Ok(FromList(r.Expect()))
+}
+
+// Find a longest string of characters that appear in both strings in the same order, not necessarily next to each other.
+func LongestCommonSubsequence(s string, s1 string) string {
+	return FromList(List.LongestCommonSubsequence(ToList(s), ToList(s1)))
+}
+
+// Count the least amount of single character insertions, deletions and substitutions that turn the first string into the second.
+func LevenshteinDistance(s string, s1 string) int {
+	return List.LevenshteinDistance(ToList(s), ToList(s1))
+}
@@ -0,0 +1,97 @@
+package String_test
+
+import (
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/List"
+	"github.com/manwitha1000names/gofp/v3/String"
+)
+
+func TestDiff(t *testing.T) {
+	pairs := [][2]string{
+		{"", ""},
+		{"", "abc"},
+		{"abc", ""},
+		{"kitten", "sitting"},
+		{"héllo wörld", "hello world"},
+		{"same", "same"},
+	}
+	for _, pair := range pairs {
+		edits := String.Diff(pair[0], pair[1])
+		r := String.Patch(edits, pair[0])
+		if r.IsErr() || r.Expect() != pair[1] {
+			t.Errorf("Patch(Diff(%q, %q)) = %v", pair[0], pair[1], r)
+		}
+		changes := 0
+		for _, edit := range edits {
+			if edit.Op != List.EditKeep {
+				changes++
+			}
+		}
+		lcs := len([]rune(String.LongestCommonSubsequence(pair[0], pair[1])))
+		if want := len([]rune(pair[0])) + len([]rune(pair[1])) - 2*lcs; changes != want {
+			t.Errorf("Diff(%q, %q) has %d changes, want %d", pair[0], pair[1], changes, want)
+		}
+	}
+	if r := String.Patch(String.Diff("abc", "abd"), "xbc"); r.IsOk() {
+		t.Errorf("Patch of another string = %v, want an Err", r)
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	edits := String.DiffLines("a\nb\nc", "a\nc\nd")
+	want := []List.Edit[string]{
+		{Op: List.EditKeep, OldIndex: 0, NewIndex: 0, Value: "a"},
+		{Op: List.EditDelete, OldIndex: 1, NewIndex: -1, Value: "b"},
+		{Op: List.EditKeep, OldIndex: 2, NewIndex: 1, Value: "c"},
+		{Op: List.EditInsert, OldIndex: -1, NewIndex: 2, Value: "d"},
+	}
+	if len(edits) != len(want) {
+		t.Fatalf("DiffLines = %v, want %v", edits, want)
+	}
+	for i := range want {
+		if edits[i] != want[i] {
+			t.Errorf("DiffLines[%d] = %+v, want %+v", i, edits[i], want[i])
+		}
+	}
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	cases := []struct {
+		s, s1, want string
+	}{
+		{"", "abc", ""},
+		{"abc", "abc", "abc"},
+		{"abc", "xyz", ""},
+		{"aXbYc", "abc", "abc"},
+		{"日本語", "日語", "日語"},
+	}
+	for _, c := range cases {
+		if got := String.LongestCommonSubsequence(c.s, c.s1); got != c.want {
+			t.Errorf("LongestCommonSubsequence(%q, %q) = %q, want %q", c.s, c.s1, got, c.want)
+		}
+	}
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	cases := []struct {
+		s, s1 string
+		want  int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"héllo", "hello", 1},
+		{"same", "same", 0},
+	}
+	for _, c := range cases {
+		if got := String.LevenshteinDistance(c.s, c.s1); got != c.want {
+			t.Errorf("LevenshteinDistance(%q, %q) = %d, want %d", c.s, c.s1, got, c.want)
+		}
+		if got := String.LevenshteinDistance(c.s1, c.s); got != c.want {
+			t.Errorf("LevenshteinDistance(%q, %q) = %d, want %d", c.s1, c.s, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,351 @@
+package Task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
+var (
+	ErrTimeout = errors.New("Task did not finish in time.")
+	ErrNoTasks = errors.New("No tasks were given.")
+)
+
+// Represents an asynchronous computation that eventually resolves to a `Result`.
+// A Task starts running as soon as it is created, and it resolves exactly once.
+// A Task can be copied, awaited many times and shared across goroutines freely.
+//
+// The zero value is not usable, create a Task with `Go`, `Try`, `Succeed` and friends.
+type Task[T any] struct {
+	state *state[T]
+}
+
+type state[T any] struct {
+	done   chan struct{}
+	result MaybeResult.Result[T]
+}
+
+// Something that can be waited upon, regardless of the type of its value.
+type awaitable interface {
+	finished() <-chan struct{}
+	failure() error
+}
+
+// Create a Task that is not resolved yet, and the function that resolves it.
+// The resolve function must be called exactly once.
+func pending[T any]() (Task[T], func(r MaybeResult.Result[T])) {
+	s := &state[T]{done: make(chan struct{})}
+	return Task[T]{s}, func(r MaybeResult.Result[T]) {
+		s.result = r
+		close(s.done)
+	}
+}
+
+// Run the function, turning a panic into an `Err`.
+func protect[T any](fn func() MaybeResult.Result[T]) (result MaybeResult.Result[T]) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			result = MaybeResult.Errf[T]("Task panicked: %v", rec)
+		}
+	}()
+	return fn()
+}
+
+// CONSTRUCTION
+
+// Run the function in a new goroutine, the Task resolves to its result.
+// A panic in the function resolves the Task to an `Err`.
+func Go[T any](fn func() MaybeResult.Result[T]) Task[T] {
+	t, resolve := pending[T]()
+	go func() {
+		resolve(protect(fn))
+	}()
+	return t
+}
+
+// Run the function in a new goroutine, giving it the context.
+// The Task resolves to the result of the function,
+// or to `Err(ctx.Err())` as soon as the context is done, whichever happens first.
+func GoContext[T any](ctx context.Context, fn func(ctx context.Context) MaybeResult.Result[T]) Task[T] {
+	return WithContext(ctx, Go(func() MaybeResult.Result[T] {
+		return fn(ctx)
+	}))
+}
+
+// Run a function that returns (T, error) in a new goroutine.
+func Try[T any](fn func() (T, error)) Task[T] {
+	return Go(func() MaybeResult.Result[T] {
+		return MaybeResult.Try(fn)
+	})
+}
+
+// Create a Task that is already resolved to the given `Result`.
+func Resolved[T any](r MaybeResult.Result[T]) Task[T] {
+	t, resolve := pending[T]()
+	resolve(r)
+	return t
+}
+
+// Create a Task that is already resolved to `Ok(value)`.
+func Succeed[T any](value T) Task[T] {
+	return Resolved(MaybeResult.Ok(value))
+}
+
+// Create a Task that is already resolved to `Err(err)`.
+func Fail[T any](err error) Task[T] {
+	return Resolved(MaybeResult.Err[T](err))
+}
+
+// METHODS
+
+// Block until the Task is resolved and get its result.
+func (t Task[T]) Await() MaybeResult.Result[T] {
+	<-t.state.done
+	return t.state.result
+}
+
+// Block until the Task is resolved or the context is done.
+// If the context is done first, `Err(ctx.Err())` is returned, the Task itself keeps running.
+func (t Task[T]) AwaitContext(ctx context.Context) MaybeResult.Result[T] {
+	select {
+	case <-t.state.done:
+		return t.state.result
+	case <-ctx.Done():
+		return MaybeResult.Err[T](ctx.Err())
+	}
+}
+
+// Get the result if the Task is already resolved, without blocking.
+func (t Task[T]) Poll() MaybeResult.Maybe[MaybeResult.Result[T]] {
+	select {
+	case <-t.state.done:
+		return MaybeResult.Just(t.state.result)
+	default:
+		return MaybeResult.Nothing[MaybeResult.Result[T]]()
+	}
+}
+
+// A channel that is closed once the Task is resolved, for use in select statements.
+func (t Task[T]) Done() <-chan struct{} {
+	return t.state.done
+}
+
+func (t Task[T]) finished() <-chan struct{} {
+	return t.state.done
+}
+
+func (t Task[T]) failure() error {
+	return t.state.result.Unwrap()
+}
+
+// TRANSFORM
+
+// Transform the value of a Task once it succeeds.
+//
+//	Ok(T)    => Ok(fn(T))
+//	Err(err) => Err(err)
+func Map[T, U any](mapfn func(value T) U, t Task[T]) Task[U] {
+	return Go(func() MaybeResult.Result[U] {
+		return MaybeResult.MapResult(mapfn, t.Await())
+	})
+}
+
+// Chain together asynchronous computations that may fail.
+//
+//	Ok(T)    => fn(T)
+//	Err(err) => Err(err)
+func AndThen[T, U any](fn func(value T) Task[U], t Task[T]) Task[U] {
+	return Go(func() MaybeResult.Result[U] {
+		return MaybeResult.AndThenResult(func(value T) MaybeResult.Result[U] {
+			return fn(value).Await()
+		}, t.Await())
+	})
+}
+
+// Recover from a failed Task with another Task.
+//
+//	Ok(T)    => Ok(T)
+//	Err(err) => fn(err)
+func OrElse[T any](fn func(err error) Task[T], t Task[T]) Task[T] {
+	return Go(func() MaybeResult.Result[T] {
+		return MaybeResult.Fold(t.Await(), MaybeResult.Ok[T], func(err error) MaybeResult.Result[T] {
+			return fn(err).Await()
+		})
+	})
+}
+
+// COMBINE
+
+// Wait until all the tasks are resolved, or until the first of them fails.
+// Returns the error of the first failing task, or nil if all of them succeeded.
+func awaitAll(tasks ...awaitable) error {
+	finished := make(chan awaitable, len(tasks))
+	for _, t := range tasks {
+		go func(t awaitable) {
+			<-t.finished()
+			finished <- t
+		}(t)
+	}
+	for range tasks {
+		if err := (<-finished).failure(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Combine two tasks running concurrently, combining their values with the given function.
+// If any of the tasks fails, the result fails with the first error without waiting for the others.
+func Map2[a, b, result any](mapfn func(a a, b b) result, taskA Task[a], taskB Task[b]) Task[result] {
+	return Go(func() MaybeResult.Result[result] {
+		if err := awaitAll(taskA, taskB); err != nil {
+			return MaybeResult.Err[result](err)
+		}
+		return MaybeResult.Ok(mapfn(taskA.state.result.Expect(), taskB.state.result.Expect()))
+	})
+}
+
+// Combine three tasks running concurrently, combining their values with the given function.
+// If any of the tasks fails, the result fails with the first error without waiting for the others.
+func Map3[a, b, c, result any](mapfn func(a a, b b, c c) result, taskA Task[a], taskB Task[b], taskC Task[c]) Task[result] {
+	return Go(func() MaybeResult.Result[result] {
+		if err := awaitAll(taskA, taskB, taskC); err != nil {
+			return MaybeResult.Err[result](err)
+		}
+		return MaybeResult.Ok(mapfn(taskA.state.result.Expect(), taskB.state.result.Expect(), taskC.state.result.Expect()))
+	})
+}
+
+// Combine four tasks running concurrently, combining their values with the given function.
+// If any of the tasks fails, the result fails with the first error without waiting for the others.
+func Map4[a, b, c, d, result any](mapfn func(a a, b b, c c, d d) result, taskA Task[a], taskB Task[b], taskC Task[c], taskD Task[d]) Task[result] {
+	return Go(func() MaybeResult.Result[result] {
+		if err := awaitAll(taskA, taskB, taskC, taskD); err != nil {
+			return MaybeResult.Err[result](err)
+		}
+		return MaybeResult.Ok(mapfn(taskA.state.result.Expect(), taskB.state.result.Expect(), taskC.state.result.Expect(), taskD.state.result.Expect()))
+	})
+}
+
+// Combine five tasks running concurrently, combining their values with the given function.
+// If any of the tasks fails, the result fails with the first error without waiting for the others.
+func Map5[a, b, c, d, e, result any](mapfn func(a a, b b, c c, d d, e e) result, taskA Task[a], taskB Task[b], taskC Task[c], taskD Task[d], taskE Task[e]) Task[result] {
+	return Go(func() MaybeResult.Result[result] {
+		if err := awaitAll(taskA, taskB, taskC, taskD, taskE); err != nil {
+			return MaybeResult.Err[result](err)
+		}
+		return MaybeResult.Ok(mapfn(taskA.state.result.Expect(), taskB.state.result.Expect(), taskC.state.result.Expect(), taskD.state.result.Expect(), taskE.state.result.Expect()))
+	})
+}
+
+// Wait for all the tasks, collecting their values in the same order as the tasks.
+// If any of the tasks fails, the result fails with the first error without waiting for the others.
+func All[T any](tasks []Task[T]) Task[[]T] {
+	return Go(func() MaybeResult.Result[[]T] {
+		waiting := make([]awaitable, len(tasks))
+		for i, t := range tasks {
+			waiting[i] = t
+		}
+		if err := awaitAll(waiting...); err != nil {
+			return MaybeResult.Err[[]T](err)
+		}
+		values := make([]T, len(tasks))
+		for i, t := range tasks {
+			values[i] = t.state.result.Expect()
+		}
+		return MaybeResult.Ok(values)
+	})
+}
+
+// Resolve to the result of whichever task resolves first, be it a success or a failure.
+// If no tasks are given, the result fails with `ErrNoTasks`.
+func Race[T any](tasks []Task[T]) Task[T] {
+	if len(tasks) == 0 {
+		return Fail[T](ErrNoTasks)
+	}
+	t, resolve := pending[T]()
+	go func() {
+		first := make(chan MaybeResult.Result[T], len(tasks))
+		for _, task := range tasks {
+			go func(task Task[T]) {
+				first <- task.Await()
+			}(task)
+		}
+		resolve(<-first)
+	}()
+	return t
+}
+
+// Resolve to the value of whichever task succeeds first.
+// If all the tasks fail, the result fails with all of their errors joined together.
+// If no tasks are given, the result fails with `ErrNoTasks`.
+func AnySuccess[T any](tasks []Task[T]) Task[T] {
+	if len(tasks) == 0 {
+		return Fail[T](ErrNoTasks)
+	}
+	return Go(func() MaybeResult.Result[T] {
+		finished := make(chan MaybeResult.Result[T], len(tasks))
+		for _, task := range tasks {
+			go func(task Task[T]) {
+				finished <- task.Await()
+			}(task)
+		}
+		errs := make([]error, 0, len(tasks))
+		for range tasks {
+			r := <-finished
+			if r.IsOk() {
+				return r
+			}
+			errs = append(errs, r.Unwrap())
+		}
+		return MaybeResult.Err[T](errors.Join(errs...))
+	})
+}
+
+// CONTROL
+
+// Fail with `ErrTimeout` if the task has not resolved within the given duration.
+// The original task keeps running, only the returned task gives up waiting.
+func Timeout[T any](duration time.Duration, t Task[T]) Task[T] {
+	return Go(func() MaybeResult.Result[T] {
+		timer := time.NewTimer(duration)
+		defer timer.Stop()
+		select {
+		case <-t.state.done:
+			return t.state.result
+		case <-timer.C:
+			return MaybeResult.Err[T](fmt.Errorf("%w (after %s)", ErrTimeout, duration))
+		}
+	})
+}
+
+// Fail with `ctx.Err()` if the context is done before the task has resolved.
+// The original task keeps running, only the returned task gives up waiting.
+func WithContext[T any](ctx context.Context, t Task[T]) Task[T] {
+	return Go(func() MaybeResult.Result[T] {
+		return t.AwaitContext(ctx)
+	})
+}
+
+// Start the task created by the function, starting it over when it fails,
+// until it succeeds or it has been attempted the given amount of times.
+// The task is always attempted at least once.
+// If every attempt fails, the result fails with all of their errors joined together.
+func Retry[T any](attempts int, fn func() Task[T]) Task[T] {
+	attempts = Basics.Max(attempts, 1)
+	return Go(func() MaybeResult.Result[T] {
+		errs := make([]error, 0, attempts)
+		for i := 0; i < attempts; i++ {
+			r := fn().Await()
+			if r.IsOk() {
+				return r
+			}
+			errs = append(errs, r.Unwrap())
+		}
+		return MaybeResult.Err[T](errors.Join(errs...))
+	})
+}
@@ -0,0 +1,274 @@
+package Task_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Task"
+)
+
+// Create a Task that only resolves to the value once the test is over,
+// standing in for a computation that takes forever.
+func never[T any](t *testing.T, value T) Task.Task[T] {
+	gate := make(chan struct{})
+	t.Cleanup(func() { close(gate) })
+	return Task.Go(func() MaybeResult.Result[T] {
+		<-gate
+		return MaybeResult.Ok(value)
+	})
+}
+
+// Fail the test if the Task does not resolve within a generous deadline.
+func await[T any](t *testing.T, task Task.Task[T]) MaybeResult.Result[T] {
+	t.Helper()
+	select {
+	case <-task.Done():
+		return task.Await()
+	case <-time.After(5 * time.Second):
+		t.Fatal("the task did not resolve")
+		panic("unreachable")
+	}
+}
+
+func TestGoAndAwait(t *testing.T) {
+	gate := make(chan struct{})
+	task := Task.Go(func() MaybeResult.Result[int] {
+		<-gate
+		return MaybeResult.Ok(1)
+	})
+	if task.Poll().IsJust() {
+		t.Error("Poll found a result before the task resolved")
+	}
+	close(gate)
+	if r := await(t, task); r.Expect() != 1 {
+		t.Errorf("Await = %v, want Ok(1)", r)
+	}
+	if r := task.Await(); r.Expect() != 1 {
+		t.Errorf("second Await = %v, want Ok(1)", r)
+	}
+	if p := task.Poll(); p.IsNothing() || p.Expect().Expect() != 1 {
+		t.Errorf("Poll after resolving = %v", p)
+	}
+}
+
+func TestPanicBecomesErr(t *testing.T) {
+	r := await(t, Task.Go(func() MaybeResult.Result[int] {
+		panic("boom")
+	}))
+	if r.IsOk() || !strings.Contains(r.Unwrap().Error(), "boom") {
+		t.Errorf("Await = %v, want an Err mentioning the panic", r)
+	}
+}
+
+func TestConstructors(t *testing.T) {
+	if r := await(t, Task.Try(func() (int, error) { return 2, nil })); r.Expect() != 2 {
+		t.Errorf("Try = %v", r)
+	}
+	if r := await(t, Task.Try(func() (int, error) { return 0, io.EOF })); r.Unwrap() != io.EOF {
+		t.Errorf("Try = %v", r)
+	}
+	if r := Task.Succeed(3).Poll(); r.IsNothing() || r.Expect().Expect() != 3 {
+		t.Errorf("Succeed is not resolved right away: %v", r)
+	}
+	if r := Task.Fail[int](io.EOF).Await(); r.Unwrap() != io.EOF {
+		t.Errorf("Fail = %v", r)
+	}
+	if r := Task.Resolved(MaybeResult.Ok("x")).Await(); r.Expect() != "x" {
+		t.Errorf("Resolved = %v", r)
+	}
+}
+
+func TestAwaitContext(t *testing.T) {
+	task := never(t, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if r := task.AwaitContext(ctx); !errors.Is(r, context.Canceled) {
+		t.Errorf("AwaitContext = %v, want Err(context.Canceled)", r)
+	}
+	if task.Poll().IsJust() {
+		t.Error("giving up on waiting resolved the task")
+	}
+}
+
+func TestTransform(t *testing.T) {
+	double := func(n int) int { return n * 2 }
+	if r := await(t, Task.Map(double, Task.Succeed(2))); r.Expect() != 4 {
+		t.Errorf("Map(Ok) = %v", r)
+	}
+	if r := await(t, Task.Map(double, Task.Fail[int](io.EOF))); r.Unwrap() != io.EOF {
+		t.Errorf("Map(Err) = %v", r)
+	}
+	half := func(n int) Task.Task[int] {
+		if n%2 != 0 {
+			return Task.Fail[int](errors.New("odd"))
+		}
+		return Task.Succeed(n / 2)
+	}
+	if r := await(t, Task.AndThen(half, Task.Succeed(4))); r.Expect() != 2 {
+		t.Errorf("AndThen(Ok(4)) = %v", r)
+	}
+	if r := await(t, Task.AndThen(half, Task.Succeed(3))); r.IsOk() {
+		t.Errorf("AndThen(Ok(3)) = %v", r)
+	}
+	if r := await(t, Task.AndThen(half, Task.Fail[int](io.EOF))); r.Unwrap() != io.EOF {
+		t.Errorf("AndThen(Err) = %v", r)
+	}
+	fallback := func(err error) Task.Task[int] { return Task.Succeed(-1) }
+	if r := await(t, Task.OrElse(fallback, Task.Fail[int](io.EOF))); r.Expect() != -1 {
+		t.Errorf("OrElse(Err) = %v", r)
+	}
+	if r := await(t, Task.OrElse(fallback, Task.Succeed(1))); r.Expect() != 1 {
+		t.Errorf("OrElse(Ok) = %v", r)
+	}
+}
+
+func TestMapTaskKeepsTheStackOfAnErr(t *testing.T) {
+	MaybeResult.CaptureStackTraces(true)
+	defer MaybeResult.CaptureStackTraces(false)
+	failed := Task.Fail[int](io.EOF)
+	want := MaybeResult.StackTrace(failed.Await())
+	got := MaybeResult.StackTrace(await(t, Task.Map(func(n int) int { return n }, failed)))
+	if len(want) == 0 || len(got) != len(want) || got[0] != want[0] {
+		t.Error("Map replaced the stack of the Err")
+	}
+}
+
+func TestCombine(t *testing.T) {
+	sum := await(t, Task.Map3(func(a, b, c int) int { return a + b + c }, Task.Succeed(1), Task.Succeed(2), Task.Succeed(3)))
+	if sum.Expect() != 6 {
+		t.Errorf("Map3 = %v", sum)
+	}
+	pair := await(t, Task.Map2(func(a int, b string) string { return strings.Repeat(b, a) }, Task.Succeed(2), Task.Succeed("ab")))
+	if pair.Expect() != "abab" {
+		t.Errorf("Map2 = %v", pair)
+	}
+	five := await(t, Task.Map5(func(a, b, c, d, e int) int { return a * b * c * d * e },
+		Task.Succeed(1), Task.Succeed(2), Task.Succeed(3), Task.Succeed(4), Task.Succeed(5)))
+	if five.Expect() != 120 {
+		t.Errorf("Map5 = %v", five)
+	}
+}
+
+func TestCombineFailsFast(t *testing.T) {
+	r := await(t, Task.Map2(func(a, b int) int { return a + b }, never(t, 1), Task.Fail[int](io.EOF)))
+	if r.Unwrap() != io.EOF {
+		t.Errorf("Map2 = %v, want Err(EOF) without waiting for the other task", r)
+	}
+	all := await(t, Task.All([]Task.Task[int]{never(t, 1), Task.Fail[int](io.EOF)}))
+	if all.Unwrap() != io.EOF {
+		t.Errorf("All = %v, want Err(EOF) without waiting for the other task", all)
+	}
+}
+
+func TestAllKeepsTheOrder(t *testing.T) {
+	gates := []chan struct{}{make(chan struct{}), make(chan struct{}), make(chan struct{})}
+	tasks := make([]Task.Task[int], len(gates))
+	for i := range gates {
+		i := i
+		tasks[i] = Task.Go(func() MaybeResult.Result[int] {
+			<-gates[i]
+			return MaybeResult.Ok(i)
+		})
+	}
+	// Resolve the tasks in reverse order.
+	for i := len(gates) - 1; i >= 0; i-- {
+		close(gates[i])
+		<-tasks[i].Done()
+	}
+	r := await(t, Task.All(tasks))
+	if got := r.Expect(); len(got) != 3 || got[0] != 0 || got[1] != 1 || got[2] != 2 {
+		t.Errorf("All = %v, want [0 1 2]", got)
+	}
+	if r := await(t, Task.All([]Task.Task[int]{})); len(r.Expect()) != 0 {
+		t.Errorf("All of no tasks = %v", r)
+	}
+}
+
+func TestRace(t *testing.T) {
+	if r := await(t, Task.Race([]Task.Task[int]{never(t, 1), Task.Succeed(2)})); r.Expect() != 2 {
+		t.Errorf("Race = %v, want Ok(2)", r)
+	}
+	if r := await(t, Task.Race([]Task.Task[int]{never(t, 1), Task.Fail[int](io.EOF)})); r.Unwrap() != io.EOF {
+		t.Errorf("Race = %v, want the first result even if it failed", r)
+	}
+	if r := Task.Race([]Task.Task[int]{}).Await(); r.Unwrap() != Task.ErrNoTasks {
+		t.Errorf("Race of no tasks = %v", r)
+	}
+}
+
+func TestAnySuccess(t *testing.T) {
+	r := await(t, Task.AnySuccess([]Task.Task[int]{Task.Fail[int](io.EOF), never(t, 1), Task.Succeed(2)}))
+	if r.Expect() != 2 {
+		t.Errorf("AnySuccess = %v, want Ok(2)", r)
+	}
+	errA, errB := errors.New("a"), errors.New("b")
+	r = await(t, Task.AnySuccess([]Task.Task[int]{Task.Fail[int](errA), Task.Fail[int](errB)}))
+	if !errors.Is(r, errA) || !errors.Is(r, errB) {
+		t.Errorf("AnySuccess = %v, want both errors joined", r)
+	}
+	if r := Task.AnySuccess([]Task.Task[int]{}).Await(); r.Unwrap() != Task.ErrNoTasks {
+		t.Errorf("AnySuccess of no tasks = %v", r)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	if r := await(t, Task.Timeout(time.Millisecond, never(t, 1))); !errors.Is(r, Task.ErrTimeout) {
+		t.Errorf("Timeout = %v, want Err(ErrTimeout)", r)
+	}
+	if r := await(t, Task.Timeout(time.Hour, Task.Succeed(1))); r.Expect() != 1 {
+		t.Errorf("Timeout = %v, want Ok(1)", r)
+	}
+}
+
+func TestGoContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	started, gate := make(chan struct{}), make(chan struct{})
+	defer close(gate)
+	task := Task.GoContext(ctx, func(ctx context.Context) MaybeResult.Result[int] {
+		close(started)
+		<-gate
+		return MaybeResult.Ok(1)
+	})
+	<-started
+	cancel()
+	if r := await(t, task); !errors.Is(r, context.Canceled) {
+		t.Errorf("GoContext = %v, want Err(context.Canceled)", r)
+	}
+	if r := await(t, Task.WithContext(context.Background(), Task.Succeed(2))); r.Expect() != 2 {
+		t.Errorf("WithContext = %v", r)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	var attempts atomic.Int32
+	flaky := func() Task.Task[int] {
+		return Task.Go(func() MaybeResult.Result[int] {
+			if attempts.Add(1) < 3 {
+				return MaybeResult.Err[int](io.EOF)
+			}
+			return MaybeResult.Ok(7)
+		})
+	}
+	if r := await(t, Task.Retry(5, flaky)); r.Expect() != 7 || attempts.Load() != 3 {
+		t.Errorf("Retry = %v after %d attempts, want Ok(7) after 3", r, attempts.Load())
+	}
+
+	attempts.Store(0)
+	failing := func() Task.Task[int] {
+		attempts.Add(1)
+		return Task.Fail[int](io.EOF)
+	}
+	if r := await(t, Task.Retry(2, failing)); !errors.Is(r, io.EOF) || attempts.Load() != 2 {
+		t.Errorf("Retry = %v after %d attempts, want Err(EOF) after 2", r, attempts.Load())
+	}
+	attempts.Store(0)
+	if await(t, Task.Retry(0, failing)); attempts.Load() != 1 {
+		t.Errorf("Retry(0) made %d attempts, want at least one", attempts.Load())
+	}
+}
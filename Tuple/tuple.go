@@ -1,15 +1,80 @@
 package Tuple
 
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	. "github.com/manwitha1000names/gofp/v3/MaybeResult"
+)
+
 type Tuple[T, U any] struct {
 	Fst T
 	Snd U
 }
 
+type Tuple3[T, U, V any] struct {
+	Fst T
+	Snd U
+	Thd V
+}
+
+type Tuple4[T, U, V, W any] struct {
+	Fst T
+	Snd U
+	Thd V
+	Fth W
+}
+
+type Tuple5[T, U, V, W, X any] struct {
+	Fst T
+	Snd U
+	Thd V
+	Fth W
+	Fif X
+}
+
+// CONSTRUCTION
+
 // Create a 2-tuple.
 func Pair[T, U any](a T, b U) Tuple[T, U] {
 	return Tuple[T, U]{a, b}
 }
 
+// Create a 3-tuple.
+func Triple[T, U, V any](a T, b U, c V) Tuple3[T, U, V] {
+	return Tuple3[T, U, V]{a, b, c}
+}
+
+// Create a 4-tuple.
+func Quadruple[T, U, V, W any](a T, b U, c V, d W) Tuple4[T, U, V, W] {
+	return Tuple4[T, U, V, W]{a, b, c, d}
+}
+
+// Create a 5-tuple.
+func Quintuple[T, U, V, W, X any](a T, b U, c V, d W, e X) Tuple5[T, U, V, W, X] {
+	return Tuple5[T, U, V, W, X]{a, b, c, d, e}
+}
+
+// Add a value to the end of a 2-tuple.
+func Extend[T, U, V any](t Tuple[T, U], c V) Tuple3[T, U, V] {
+	return Tuple3[T, U, V]{t.Fst, t.Snd, c}
+}
+
+// Add a value to the end of a 3-tuple.
+func Extend3[T, U, V, W any](t Tuple3[T, U, V], d W) Tuple4[T, U, V, W] {
+	return Tuple4[T, U, V, W]{t.Fst, t.Snd, t.Thd, d}
+}
+
+// Add a value to the end of a 4-tuple.
+func Extend4[T, U, V, W, X any](t Tuple4[T, U, V, W], e X) Tuple5[T, U, V, W, X] {
+	return Tuple5[T, U, V, W, X]{t.Fst, t.Snd, t.Thd, t.Fth, e}
+}
+
+// ACCESS
+
 // Extract the first value from a tuple.
 func First[T, U any](t Tuple[T, U]) T {
 	return t.Fst
@@ -20,6 +85,44 @@ func Second[T, U any](t Tuple[T, U]) U {
 	return t.Snd
 }
 
+// Extract the first value.
+func (t Tuple3[T, U, V]) First() T { return t.Fst }
+
+// Extract the second value.
+func (t Tuple3[T, U, V]) Second() U { return t.Snd }
+
+// Extract the third value.
+func (t Tuple3[T, U, V]) Third() V { return t.Thd }
+
+// Extract the first value.
+func (t Tuple4[T, U, V, W]) First() T { return t.Fst }
+
+// Extract the second value.
+func (t Tuple4[T, U, V, W]) Second() U { return t.Snd }
+
+// Extract the third value.
+func (t Tuple4[T, U, V, W]) Third() V { return t.Thd }
+
+// Extract the fourth value.
+func (t Tuple4[T, U, V, W]) Fourth() W { return t.Fth }
+
+// Extract the first value.
+func (t Tuple5[T, U, V, W, X]) First() T { return t.Fst }
+
+// Extract the second value.
+func (t Tuple5[T, U, V, W, X]) Second() U { return t.Snd }
+
+// Extract the third value.
+func (t Tuple5[T, U, V, W, X]) Third() V { return t.Thd }
+
+// Extract the fourth value.
+func (t Tuple5[T, U, V, W, X]) Fourth() W { return t.Fth }
+
+// Extract the fifth value.
+func (t Tuple5[T, U, V, W, X]) Fifth() X { return t.Fif }
+
+// TRANSFORM
+
 // Transform the first value in a tuple.
 func MapFirst[T, U, E any](mapfn func(value T) E, t Tuple[T, U]) Tuple[E, U] {
 	return Tuple[E, U]{mapfn(t.Fst), t.Snd}
@@ -34,3 +137,271 @@ func MapSecond[T, U, E any](mapfn func(value U) E, t Tuple[T, U]) Tuple[T, E] {
 func MapBoth[T, U, E, D any](mapfnF func(valueF T) E, mapfnS func(valueS U) D, t Tuple[T, U]) Tuple[E, D] {
 	return Tuple[E, D]{mapfnF(t.Fst), mapfnS(t.Snd)}
 }
+
+// Swap the two values of a tuple.
+func Swap[T, U any](t Tuple[T, U]) Tuple[U, T] {
+	return Tuple[U, T]{t.Snd, t.Fst}
+}
+
+// Call a function of two arguments with the two values of a tuple.
+func Apply[T, U, R any](fn func(a T, b U) R, t Tuple[T, U]) R {
+	return fn(t.Fst, t.Snd)
+}
+
+// Transform every part of a 3-tuple.
+func Map3[a, b, c, a1, b1, c1 any](mapfnA func(a a) a1, mapfnB func(b b) b1, mapfnC func(c c) c1, t Tuple3[a, b, c]) Tuple3[a1, b1, c1] {
+	return Tuple3[a1, b1, c1]{mapfnA(t.Fst), mapfnB(t.Snd), mapfnC(t.Thd)}
+}
+
+// Transform every part of a 4-tuple.
+func Map4[a, b, c, d, a1, b1, c1, d1 any](mapfnA func(a a) a1, mapfnB func(b b) b1, mapfnC func(c c) c1, mapfnD func(d d) d1, t Tuple4[a, b, c, d]) Tuple4[a1, b1, c1, d1] {
+	return Tuple4[a1, b1, c1, d1]{mapfnA(t.Fst), mapfnB(t.Snd), mapfnC(t.Thd), mapfnD(t.Fth)}
+}
+
+// Transform every part of a 5-tuple.
+func Map5[a, b, c, d, e, a1, b1, c1, d1, e1 any](mapfnA func(a a) a1, mapfnB func(b b) b1, mapfnC func(c c) c1, mapfnD func(d d) d1, mapfnE func(e e) e1, t Tuple5[a, b, c, d, e]) Tuple5[a1, b1, c1, d1, e1] {
+	return Tuple5[a1, b1, c1, d1, e1]{mapfnA(t.Fst), mapfnB(t.Snd), mapfnC(t.Thd), mapfnD(t.Fth), mapfnE(t.Fif)}
+}
+
+// SEQUENCE
+
+// Turn a tuple of `Maybe`s into a `Maybe` of a tuple.
+//
+//	(Just(a), Just(b)) => Just((a, b))
+//	otherwise          => Nothing
+func SequenceMaybe[T, U any](t Tuple[Maybe[T], Maybe[U]]) Maybe[Tuple[T, U]] {
+	if t.Fst.IsNothing() || t.Snd.IsNothing() {
+		return Nothing[Tuple[T, U]]()
+	}
+	return Just(Tuple[T, U]{t.Fst.Expect(), t.Snd.Expect()})
+}
+
+// Turn a tuple of `Result`s into a `Result` of a tuple, failing with the first error.
+//
+//	(Ok(a), Ok(b))    => Ok((a, b))
+//	(Err(err), _)     => Err(err)
+//	(Ok(a), Err(err)) => Err(err)
+func SequenceResult[T, U any](t Tuple[Result[T], Result[U]]) Result[Tuple[T, U]] {
+	if t.Fst.IsErr() {
+		return Err[Tuple[T, U]](t.Fst.Unwrap())
+	}
+	if t.Snd.IsErr() {
+		return Err[Tuple[T, U]](t.Snd.Unwrap())
+	}
+	return Ok(Tuple[T, U]{t.Fst.Expect(), t.Snd.Expect()})
+}
+
+// COMPARISON
+
+// Compare two tuples lexicographically: by their first values, and by their second values when the first ones are equal.
+// Use it with `List.SortWith` to sort a list of tuples.
+func Compare[T, U Basics.Ordered](a, b Tuple[T, U]) Basics.Order {
+	return CompareWith(Basics.Compare[T], Basics.Compare[U])(a, b)
+}
+
+// Create a function that compares tuples lexicographically, with a comparison function for each of the values.
+func CompareWith[T, U any](cmpfnF func(a, b T) Basics.Order, cmpfnS func(a, b U) Basics.Order) func(a, b Tuple[T, U]) Basics.Order {
+	return func(a, b Tuple[T, U]) Basics.Order {
+		if order := cmpfnF(a.Fst, b.Fst); order != Basics.EQ {
+			return order
+		}
+		return cmpfnS(a.Snd, b.Snd)
+	}
+}
+
+// CURRYING
+
+// Turn a function of a 2-tuple into a function of two arguments.
+func Curry[T, U, R any](fn func(t Tuple[T, U]) R) func(a T, b U) R {
+	return func(a T, b U) R {
+		return fn(Tuple[T, U]{a, b})
+	}
+}
+
+// Turn a function of two arguments into a function of a tuple.
+func Uncurry[T, U, R any](fn func(a T, b U) R) func(t Tuple[T, U]) R {
+	return func(t Tuple[T, U]) R {
+		return fn(t.Fst, t.Snd)
+	}
+}
+
+// Turn a function of a 3-tuple into a function of three arguments.
+func Curry3[T, U, V, R any](fn func(t Tuple3[T, U, V]) R) func(a T, b U, c V) R {
+	return func(a T, b U, c V) R {
+		return fn(Tuple3[T, U, V]{a, b, c})
+	}
+}
+
+// Turn a function of three arguments into a function of a 3-tuple.
+func Uncurry3[T, U, V, R any](fn func(a T, b U, c V) R) func(t Tuple3[T, U, V]) R {
+	return func(t Tuple3[T, U, V]) R {
+		return fn(t.Fst, t.Snd, t.Thd)
+	}
+}
+
+// Turn a function of a 4-tuple into a function of four arguments.
+func Curry4[T, U, V, W, R any](fn func(t Tuple4[T, U, V, W]) R) func(a T, b U, c V, d W) R {
+	return func(a T, b U, c V, d W) R {
+		return fn(Tuple4[T, U, V, W]{a, b, c, d})
+	}
+}
+
+// Turn a function of four arguments into a function of a 4-tuple.
+func Uncurry4[T, U, V, W, R any](fn func(a T, b U, c V, d W) R) func(t Tuple4[T, U, V, W]) R {
+	return func(t Tuple4[T, U, V, W]) R {
+		return fn(t.Fst, t.Snd, t.Thd, t.Fth)
+	}
+}
+
+// Turn a function of a 5-tuple into a function of five arguments.
+func Curry5[T, U, V, W, X, R any](fn func(t Tuple5[T, U, V, W, X]) R) func(a T, b U, c V, d W, e X) R {
+	return func(a T, b U, c V, d W, e X) R {
+		return fn(Tuple5[T, U, V, W, X]{a, b, c, d, e})
+	}
+}
+
+// Turn a function of five arguments into a function of a 5-tuple.
+func Uncurry5[T, U, V, W, X, R any](fn func(a T, b U, c V, d W, e X) R) func(t Tuple5[T, U, V, W, X]) R {
+	return func(t Tuple5[T, U, V, W, X]) R {
+		return fn(t.Fst, t.Snd, t.Thd, t.Fth, t.Fif)
+	}
+}
+
+// FORMATTING
+
+// The names of the fields of a tuple, in order.
+var fieldNames = []string{"Fst", "Snd", "Thd", "Fth", "Fif"}
+
+// Format a tuple as text for the %v, %s and %q verbs,
+// and like fmt formats a struct for %+v, %#v and every other verb.
+func format(f fmt.State, verb rune, tuple any, text string, fields ...any) {
+	switch {
+	case verb == 's' || verb == 'q' || (verb == 'v' && !f.Flag('+') && !f.Flag('#')):
+		fmt.Fprintf(f, fmt.FormatString(f, verb), text)
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "%s{", reflect.TypeOf(tuple))
+		for i, field := range fields {
+			if i > 0 {
+				_, _ = io.WriteString(f, ", ")
+			}
+			fmt.Fprintf(f, "%s:%#v", fieldNames[i], field)
+		}
+		_, _ = io.WriteString(f, "}")
+	default:
+		directive := fmt.FormatString(f, verb)
+		_, _ = io.WriteString(f, "{")
+		for i, field := range fields {
+			if i > 0 {
+				_, _ = io.WriteString(f, " ")
+			}
+			if verb == 'v' {
+				_, _ = io.WriteString(f, fieldNames[i]+":")
+			}
+			fmt.Fprintf(f, directive, field)
+		}
+		_, _ = io.WriteString(f, "}")
+	}
+}
+
+// Print the tuple as (a, b).
+func (t Tuple[T, U]) String() string {
+	return fmt.Sprintf("(%v, %v)", t.Fst, t.Snd)
+}
+
+// Print the tuple as (a, b) for the %v, %s and %q verbs.
+// Every other verb, like %#v and %d, formats the tuple like any other struct.
+func (t Tuple[T, U]) Format(f fmt.State, verb rune) {
+	format(f, verb, t, t.String(), t.Fst, t.Snd)
+}
+
+// Print the tuple as (a, b, c).
+func (t Tuple3[T, U, V]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.Fst, t.Snd, t.Thd)
+}
+
+// Print the tuple as (a, b, c) for the %v, %s and %q verbs.
+// Every other verb, like %#v and %d, formats the tuple like any other struct.
+func (t Tuple3[T, U, V]) Format(f fmt.State, verb rune) {
+	format(f, verb, t, t.String(), t.Fst, t.Snd, t.Thd)
+}
+
+// Print the tuple as (a, b, c, d).
+func (t Tuple4[T, U, V, W]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v)", t.Fst, t.Snd, t.Thd, t.Fth)
+}
+
+// Print the tuple as (a, b, c, d) for the %v, %s and %q verbs.
+// Every other verb, like %#v and %d, formats the tuple like any other struct.
+func (t Tuple4[T, U, V, W]) Format(f fmt.State, verb rune) {
+	format(f, verb, t, t.String(), t.Fst, t.Snd, t.Thd, t.Fth)
+}
+
+// Print the tuple as (a, b, c, d, e).
+func (t Tuple5[T, U, V, W, X]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v, %v)", t.Fst, t.Snd, t.Thd, t.Fth, t.Fif)
+}
+
+// Print the tuple as (a, b, c, d, e) for the %v, %s and %q verbs.
+// Every other verb, like %#v and %d, formats the tuple like any other struct.
+func (t Tuple5[T, U, V, W, X]) Format(f fmt.State, verb rune) {
+	format(f, verb, t, t.String(), t.Fst, t.Snd, t.Thd, t.Fth, t.Fif)
+}
+
+// JSON
+
+// Decode a JSON array of exactly len(parts) elements into the parts.
+func unmarshalArray(data []byte, parts ...any) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != len(parts) {
+		return fmt.Errorf("Expected a JSON array of %d elements for a %d-tuple, got %d.", len(parts), len(parts), len(raw))
+	}
+	for i, part := range parts {
+		if err := json.Unmarshal(raw[i], part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Encode the tuple as a JSON array of 2 elements.
+func (t Tuple[T, U]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.Fst, t.Snd})
+}
+
+// Decode the tuple from a JSON array of exactly 2 elements.
+func (t *Tuple[T, U]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.Fst, &t.Snd)
+}
+
+// Encode the 3-tuple as a JSON array of 3 elements.
+func (t Tuple3[T, U, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.Fst, t.Snd, t.Thd})
+}
+
+// Decode the 3-tuple from a JSON array of exactly 3 elements.
+func (t *Tuple3[T, U, V]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.Fst, &t.Snd, &t.Thd)
+}
+
+// Encode the 4-tuple as a JSON array of 4 elements.
+func (t Tuple4[T, U, V, W]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.Fst, t.Snd, t.Thd, t.Fth})
+}
+
+// Decode the 4-tuple from a JSON array of exactly 4 elements.
+func (t *Tuple4[T, U, V, W]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.Fst, &t.Snd, &t.Thd, &t.Fth)
+}
+
+// Encode the 5-tuple as a JSON array of 5 elements.
+func (t Tuple5[T, U, V, W, X]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.Fst, t.Snd, t.Thd, t.Fth, t.Fif})
+}
+
+// Decode the 5-tuple from a JSON array of exactly 5 elements.
+func (t *Tuple5[T, U, V, W, X]) UnmarshalJSON(data []byte) error {
+	return unmarshalArray(data, &t.Fst, &t.Snd, &t.Thd, &t.Fth, &t.Fif)
+}
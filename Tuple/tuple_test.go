@@ -0,0 +1,240 @@
+package Tuple_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/manwitha1000names/gofp/v3/Basics"
+	"github.com/manwitha1000names/gofp/v3/List"
+	"github.com/manwitha1000names/gofp/v3/MaybeResult"
+	"github.com/manwitha1000names/gofp/v3/Tuple"
+)
+
+func TestCurryUncurry(t *testing.T) {
+	subtract := func(a int, b int) int { return a - b }
+	if got := Tuple.Uncurry(subtract)(Tuple.Pair(5, 3)); got != 2 {
+		t.Errorf("Uncurry = %d, want 2", got)
+	}
+	if got := Tuple.Curry(Tuple.Uncurry(subtract))(5, 3); got != 2 {
+		t.Errorf("Curry of Uncurry = %d, want 2", got)
+	}
+}
+
+func TestTupleN(t *testing.T) {
+	triple := Tuple.Triple(1, "b", true)
+	if triple.First() != 1 || triple.Second() != "b" || triple.Third() != true {
+		t.Errorf("Triple accessors of %v", triple)
+	}
+	quadruple := Tuple.Extend3(triple, 2.5)
+	if quadruple.First() != 1 || quadruple.Second() != "b" || quadruple.Third() != true || quadruple.Fourth() != 2.5 {
+		t.Errorf("Quadruple accessors of %v", quadruple)
+	}
+	quintuple := Tuple.Extend4(quadruple, 'e')
+	if quintuple != Tuple.Quintuple(1, "b", true, 2.5, 'e') || quintuple.Fifth() != 'e' {
+		t.Errorf("Extend4 = %v", quintuple)
+	}
+	if got := Tuple.Extend(Tuple.Pair(1, "b"), true); got != triple {
+		t.Errorf("Extend = %v, want %v", got, triple)
+	}
+}
+
+func TestMapN(t *testing.T) {
+	double := func(n int) int { return n * 2 }
+	length := func(s string) int { return len(s) }
+	not := func(b bool) bool { return !b }
+	if got := Tuple.Map3(double, length, not, Tuple.Triple(1, "ab", true)); got != Tuple.Triple(2, 2, false) {
+		t.Errorf("Map3 = %v", got)
+	}
+	if got := Tuple.Map4(double, length, not, double, Tuple.Quadruple(1, "ab", true, 3)); got != Tuple.Quadruple(2, 2, false, 6) {
+		t.Errorf("Map4 = %v", got)
+	}
+	if got := Tuple.Map5(double, length, not, double, length, Tuple.Quintuple(1, "ab", true, 3, "")); got != Tuple.Quintuple(2, 2, false, 6, 0) {
+		t.Errorf("Map5 = %v", got)
+	}
+}
+
+func TestCurryUncurryN(t *testing.T) {
+	join3 := func(a int, b string, c bool) string { return fmt.Sprint(a, b, c) }
+	join4 := func(a int, b string, c bool, d int) string { return fmt.Sprint(a, b, c, d) }
+	join5 := func(a int, b string, c bool, d int, e string) string { return fmt.Sprint(a, b, c, d, e) }
+	if got := Tuple.Uncurry3(join3)(Tuple.Triple(1, "b", true)); got != "1btrue" {
+		t.Errorf("Uncurry3 = %q", got)
+	}
+	if got := Tuple.Curry3(Tuple.Uncurry3(join3))(1, "b", true); got != "1btrue" {
+		t.Errorf("Curry3 of Uncurry3 = %q", got)
+	}
+	if got := Tuple.Curry4(Tuple.Uncurry4(join4))(1, "b", true, 4); got != join4(1, "b", true, 4) {
+		t.Errorf("Curry4 of Uncurry4 = %q", got)
+	}
+	if got := Tuple.Uncurry5(join5)(Tuple.Quintuple(1, "b", true, 4, "e")); got != join5(1, "b", true, 4, "e") {
+		t.Errorf("Uncurry5 = %q", got)
+	}
+	if got := Tuple.Curry5(Tuple.Uncurry5(join5))(1, "b", true, 4, "e"); got != join5(1, "b", true, 4, "e") {
+		t.Errorf("Curry5 of Uncurry5 = %q", got)
+	}
+}
+
+func TestJSONN(t *testing.T) {
+	quintuple := Tuple.Quintuple(1, "b", true, []int{4}, Tuple.Pair("x", 2.5))
+	data, err := json.Marshal(quintuple)
+	if err != nil || string(data) != `[1,"b",true,[4],["x",2.5]]` {
+		t.Fatalf("Marshal = %s, %v", data, err)
+	}
+	var decoded Tuple.Tuple5[int, string, bool, []int, Tuple.Tuple[string, float64]]
+	if err := json.Unmarshal(data, &decoded); err != nil || !reflect.DeepEqual(decoded, quintuple) {
+		t.Errorf("Unmarshal = %v, %v", decoded, err)
+	}
+
+	var triple Tuple.Tuple3[int, string, bool]
+	if err := json.Unmarshal([]byte(`[1,"b",false]`), &triple); err != nil || triple != Tuple.Triple(1, "b", false) {
+		t.Errorf("Unmarshal Tuple3 = %v, %v", triple, err)
+	}
+	failures := []string{`[1,"b"]`, `[1,"b",false,4]`, `{"Fst":1}`, `[1,2,false]`, `null`}
+	for _, failure := range failures {
+		var triple Tuple.Tuple3[int, string, bool]
+		if err := json.Unmarshal([]byte(failure), &triple); err == nil {
+			t.Errorf("Unmarshal(%s) = %v, want an error", failure, triple)
+		}
+	}
+	var quadruple Tuple.Tuple4[int, int, int, int]
+	if err := json.Unmarshal([]byte(`[1,2,3]`), &quadruple); err == nil {
+		t.Errorf("Unmarshal of 3 elements into a Tuple4 = %v, want an error", quadruple)
+	}
+}
+
+func TestSwapApply(t *testing.T) {
+	if got := Tuple.Swap(Tuple.Pair(1, "a")); got != Tuple.Pair("a", 1) {
+		t.Errorf("Swap = %v", got)
+	}
+	if got := Tuple.Swap(Tuple.Swap(Tuple.Pair(1, "a"))); got != Tuple.Pair(1, "a") {
+		t.Errorf("Swap of Swap = %v", got)
+	}
+	repeat := func(s string, n int) string { return strings.Repeat(s, n) }
+	if got := Tuple.Apply(repeat, Tuple.Pair("ab", 3)); got != "ababab" {
+		t.Errorf("Apply = %q", got)
+	}
+}
+
+func TestSequence(t *testing.T) {
+	maybes := []struct {
+		t    Tuple.Tuple[MaybeResult.Maybe[int], MaybeResult.Maybe[string]]
+		want MaybeResult.Maybe[Tuple.Tuple[int, string]]
+	}{
+		{Tuple.Pair(MaybeResult.Just(1), MaybeResult.Just("a")), MaybeResult.Just(Tuple.Pair(1, "a"))},
+		{Tuple.Pair(MaybeResult.Nothing[int](), MaybeResult.Just("a")), MaybeResult.Nothing[Tuple.Tuple[int, string]]()},
+		{Tuple.Pair(MaybeResult.Just(1), MaybeResult.Nothing[string]()), MaybeResult.Nothing[Tuple.Tuple[int, string]]()},
+		{Tuple.Pair(MaybeResult.Nothing[int](), MaybeResult.Nothing[string]()), MaybeResult.Nothing[Tuple.Tuple[int, string]]()},
+	}
+	for _, c := range maybes {
+		if got := Tuple.SequenceMaybe(c.t); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("SequenceMaybe(%v) = %v, want %v", c.t, got, c.want)
+		}
+	}
+
+	first, second := errors.New("first"), errors.New("second")
+	if got := Tuple.SequenceResult(Tuple.Pair(MaybeResult.Ok(1), MaybeResult.Ok("a"))); got.IsErr() || got.Expect() != Tuple.Pair(1, "a") {
+		t.Errorf("SequenceResult of Oks = %v", got)
+	}
+	if got := Tuple.SequenceResult(Tuple.Pair(MaybeResult.Err[int](first), MaybeResult.Err[string](second))); !errors.Is(got.Unwrap(), first) {
+		t.Errorf("SequenceResult of Errs = %v, want the first error", got)
+	}
+	if got := Tuple.SequenceResult(Tuple.Pair(MaybeResult.Ok(1), MaybeResult.Err[string](second))); !errors.Is(got.Unwrap(), second) {
+		t.Errorf("SequenceResult of an Ok and an Err = %v, want the second error", got)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		a, b Tuple.Tuple[int, string]
+		want Basics.Order
+	}{
+		{Tuple.Pair(1, "b"), Tuple.Pair(2, "a"), Basics.LT},
+		{Tuple.Pair(2, "a"), Tuple.Pair(1, "b"), Basics.GT},
+		{Tuple.Pair(1, "a"), Tuple.Pair(1, "b"), Basics.LT},
+		{Tuple.Pair(1, "b"), Tuple.Pair(1, "a"), Basics.GT},
+		{Tuple.Pair(1, "a"), Tuple.Pair(1, "a"), Basics.EQ},
+	}
+	for _, c := range cases {
+		if got := Tuple.Compare(c.a, c.b); got != c.want {
+			t.Errorf("Compare(%v, %v) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+
+	pairs := []Tuple.Tuple[int, string]{Tuple.Pair(2, "a"), Tuple.Pair(1, "b"), Tuple.Pair(1, "a"), Tuple.Pair(0, "z")}
+	want := []Tuple.Tuple[int, string]{Tuple.Pair(0, "z"), Tuple.Pair(1, "a"), Tuple.Pair(1, "b"), Tuple.Pair(2, "a")}
+	if got := List.SortWith(Tuple.Compare[int, string], pairs); !reflect.DeepEqual(got, want) {
+		t.Errorf("SortWith(Compare) = %v, want %v", got, want)
+	}
+
+	bySecondDescending := Tuple.CompareWith(Basics.Compare[int], Basics.Reversed(Basics.Compare[string]))
+	if got := bySecondDescending(Tuple.Pair(1, "a"), Tuple.Pair(1, "b")); got != Basics.GT {
+		t.Errorf("CompareWith a reversed second = %d, want GT", got)
+	}
+	if got := bySecondDescending(Tuple.Pair(0, "a"), Tuple.Pair(1, "b")); got != Basics.LT {
+		t.Errorf("CompareWith = %d, want LT", got)
+	}
+}
+
+// A struct like a pair, without the Format method, to compare with the formatting fmt does by default.
+type plainPair struct {
+	Fst int
+	Snd string
+}
+
+func TestFormat(t *testing.T) {
+	pair := Tuple.Pair(1, "a")
+	cases := []struct {
+		format, want string
+	}{
+		{"%v", "(1, a)"},
+		{"%s", "(1, a)"},
+		{"%q", `"(1, a)"`},
+		{"%10v", "    (1, a)"},
+		{"%-8s|", "(1, a)  |"},
+		{"%#v", `Tuple.Tuple[int,string]{Fst:1, Snd:"a"}`},
+	}
+	for _, c := range cases {
+		if got := fmt.Sprintf(c.format, pair); got != c.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", c.format, got, c.want)
+		}
+	}
+	for _, format := range []string{"%+v", "%d", "%5d", "%x"} {
+		if got, want := fmt.Sprintf(format, pair), fmt.Sprintf(format, plainPair{1, "a"}); got != want {
+			t.Errorf("Sprintf(%q) = %q, want the default %q", format, got, want)
+		}
+	}
+
+	if got := fmt.Sprint(pair); got != pair.String() {
+		t.Errorf("Sprint = %q, String = %q", got, pair.String())
+	}
+	if got := fmt.Sprint([]Tuple.Tuple[int, int]{Tuple.Pair(1, 2)}); got != "[(1, 2)]" {
+		t.Errorf("Sprint of a list of tuples = %q", got)
+	}
+	if got := fmt.Sprintf("%v %#v", Tuple.Triple(1, "a", 2.5), Tuple.Quadruple(1, 2, 3, 4)); got != "(1, a, 2.5) Tuple.Tuple4[int,int,int,int]{Fst:1, Snd:2, Thd:3, Fth:4}" {
+		t.Errorf("Sprintf of bigger tuples = %q", got)
+	}
+	if got := Tuple.Quintuple(1, 2, 3, 4, 5).String(); got != "(1, 2, 3, 4, 5)" {
+		t.Errorf("String of a 5-tuple = %q", got)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	data, err := json.Marshal(map[string]Tuple.Tuple[string, int]{"k": Tuple.Pair("a", 1)})
+	if err != nil || string(data) != `{"k":["a",1]}` {
+		t.Fatalf("Marshal = %s, %v", data, err)
+	}
+	var decoded map[string]Tuple.Tuple[string, int]
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded["k"] != Tuple.Pair("a", 1) {
+		t.Errorf("Unmarshal = %v, %v", decoded, err)
+	}
+	for _, failure := range []string{`["a"]`, `["a",1,2]`, `[1,1]`, `{"Fst":"a","Snd":1}`, `"a"`} {
+		var pair Tuple.Tuple[string, int]
+		if err := json.Unmarshal([]byte(failure), &pair); err == nil {
+			t.Errorf("Unmarshal(%s) = %v, want an error", failure, pair)
+		}
+	}
+}